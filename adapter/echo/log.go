@@ -10,6 +10,11 @@ import (
 	echo "github.com/labstack/gommon/log"
 )
 
+func init() {
+	// Entries logged through the adapter report the code that called it.
+	log.RegisterHelperPackage("github.com/coopnorge/go-logger/adapter/echo")
+}
+
 // WrappedEchoLogger that can be passed to Echo middleware for Datadog integration
 // implements Echo Logger from vendor/github.com/labstack/echo/v4/log.go
 type WrappedEchoLogger struct {
@@ -50,7 +55,7 @@ func (wel *WrappedEchoLogger) SetPrefix(p string) {
 // Level returns a mapped Echo log level
 func (wel *WrappedEchoLogger) Level() echo.Lvl {
 	switch wel.level {
-	case log.LevelDebug:
+	case log.LevelTrace, log.LevelDebug:
 		return echo.DEBUG
 	case log.LevelInfo:
 		return echo.INFO
@@ -58,14 +63,15 @@ func (wel *WrappedEchoLogger) Level() echo.Lvl {
 		return echo.WARN
 	case log.LevelError:
 		return echo.ERROR
-	case log.LevelFatal:
+	case log.LevelFatal, log.LevelPanic:
 		return echo.ERROR
 	default:
 		return echo.OFF
 	}
 }
 
-// SetLevel maps the log level from an Echo log level
+// SetLevel maps the log level from an Echo log level and sets it on the
+// logger of the adapter. The level of the global logger is not changed.
 func (wel *WrappedEchoLogger) SetLevel(v echo.Lvl) {
 	switch v {
 	case echo.DEBUG:
@@ -77,10 +83,10 @@ func (wel *WrappedEchoLogger) SetLevel(v echo.Lvl) {
 	case echo.ERROR:
 		wel.level = log.LevelError
 	case echo.OFF:
-		return // Ignore Coop logger cannot be disabled yet
+		wel.level = log.LevelOff
 	}
 
-	log.SetLevel(wel.level)
+	wel.log.SetLevel(wel.level)
 }
 
 // SetHeader not supported
@@ -176,19 +182,19 @@ func (wel *WrappedEchoLogger) Fatalj(j echo.JSON) {
 	wel.log.Fatal(wel.jsonToString(j))
 }
 
-// Panic wraps a call to Fatal
+// Panic logs at the panic level and then panics
 func (wel *WrappedEchoLogger) Panic(i ...any) {
-	wel.log.Fatal(i...)
+	wel.log.Panic(i...)
 }
 
-// Panicf wraps a call to Fatalf
+// Panicf logs formatted output at the panic level and then panics
 func (wel *WrappedEchoLogger) Panicf(format string, args ...any) {
-	wel.log.Fatalf(format, args...)
+	wel.log.Panicf(format, args...)
 }
 
-// Panicj wraps a call to Fatalj
+// Panicj marshals a map to JSON and logs it at the panic level and then panics
 func (wel *WrappedEchoLogger) Panicj(j echo.JSON) {
-	wel.log.Fatal(wel.jsonToString(j))
+	wel.log.Panic(wel.jsonToString(j))
 }
 
 func (wel *WrappedEchoLogger) jsonToString(j echo.JSON) string {
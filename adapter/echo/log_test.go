@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"testing"
 
+	coop "github.com/coopnorge/go-logger"
 	"github.com/labstack/gommon/log"
 )
 
@@ -33,3 +34,41 @@ func TestWrappedEchoLogger(_ *testing.T) {
 	l.Errorf("error%s", "f")
 	l.Errorj(jTest)
 }
+
+func TestWrappedEchoLoggerLevelOff(t *testing.T) {
+	b := new(bytes.Buffer)
+	l := NewWrappedEchoLogger()
+	l.log = coop.New(coop.WithOutput(b), coop.WithLevel(coop.LevelInfo))
+	globalLevel := coop.Global().Level()
+
+	l.SetLevel(log.OFF)
+	if l.Level() != log.OFF {
+		t.Fatalf("expected level %v, got %v", log.OFF, l.Level())
+	}
+	l.Error("error")
+	if b.Len() != 0 {
+		t.Fatalf("expected no output, got %q", b.String())
+	}
+	if coop.Global().Level() != globalLevel {
+		t.Fatalf("expected global level %v, got %v", globalLevel, coop.Global().Level())
+	}
+}
+
+func TestWrappedEchoLoggerPanicCanBeRecovered(t *testing.T) {
+	l := NewWrappedEchoLogger()
+
+	for _, panicFunc := range []func(){
+		func() { l.Panic("panic") },
+		func() { l.Panicf("panic%s", "f") },
+		func() { l.Panicj(log.JSON{"name": "value"}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected a panic")
+				}
+			}()
+			panicFunc()
+		}()
+	}
+}
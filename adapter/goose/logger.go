@@ -4,6 +4,13 @@ import (
 	log "github.com/coopnorge/go-logger"
 )
 
+func init() {
+	// Entries logged through the adapter report the application code that
+	// called Goose, not the adapter or Goose itself.
+	log.RegisterHelperPackage("github.com/coopnorge/go-logger/adapter/goose")
+	log.RegisterHelperPackage("github.com/pressly/goose/v3")
+}
+
 // Logger is a logging adapter between Goose and go-logger
 type Logger struct {
 	log *log.Logger
@@ -9,6 +9,13 @@ import (
 	"gorm.io/gorm/logger"
 )
 
+func init() {
+	// Entries logged through the adapter report the application code that
+	// called Gorm, not the adapter or Gorm itself.
+	coopLogger.RegisterHelperPackage("github.com/coopnorge/go-logger/adapter/gorm")
+	coopLogger.RegisterHelperPackage("gorm.io/gorm")
+}
+
 // Logger is a logging adapter between Gorm an go-logger, do not create
 // this directly, use NewLogger()
 type Logger struct {
@@ -87,5 +94,5 @@ func (l *Logger) Trace(ctx context.Context, begin time.Time, fc func() (sql stri
 		e.WithError(err).Error()
 		return
 	}
-	e.Debug()
+	e.Trace()
 }
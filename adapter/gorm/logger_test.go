@@ -95,7 +95,7 @@ func TestTraceEnabled(t *testing.T) {
 
 	output := &strings.Builder{}
 	logger, err := NewLogger(
-		WithLogger(coopLogger.New(coopLogger.WithLevel(coopLogger.LevelDebug), coopLogger.WithOutput(output))),
+		WithLogger(coopLogger.New(coopLogger.WithLevel(coopLogger.LevelTrace), coopLogger.WithOutput(output))),
 		WithSQLTrace(),
 	)
 	require.NoError(t, err)
@@ -31,7 +31,8 @@ func WithLogger(logger *coopLogger.Logger) LoggerOption {
 	})
 }
 
-// WithSQLTrace configures Gorm to output SQL trace logs
+// WithSQLTrace configures Gorm to output SQL trace logs. Successful
+// statements are logged at the trace level, failed ones at the error level.
 func WithSQLTrace() LoggerOption {
 	return LoggerOptionFunc(func(l *Logger) {
 		l.traceEnabled = true
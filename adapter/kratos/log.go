@@ -5,6 +5,13 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+func init() {
+	// Entries logged through the adapter and the log helpers of Go-Kratos
+	// report the code that called them.
+	coopLog.RegisterHelperPackage("github.com/coopnorge/go-logger/adapter/kratos")
+	coopLog.RegisterHelperPackage("github.com/go-kratos/kratos/v2/log")
+}
+
 // Ensure LoggerKratosAdapter implements the log.Logger interface.
 var _ log.Logger = (*LoggerKratosAdapter)(nil)
 
@@ -36,13 +43,14 @@ func NewLoggerKratosAdapter(coopLog *coopLog.Logger) *LoggerKratosAdapter {
 	return &LoggerKratosAdapter{log: coopLog}
 }
 
-// Log prints the keyValPairs to the log.
+// Log prints the keyValPairs to the log. Kratos debug messages are logged at
+// the trace level, so they do not drown out the debug logs of the service.
 //
 // Example of logs:
 //
-// {"file":"/project/internal/pkg/logs/kratos.go:36","function":"xxx","level":"debug","msg":"msgconfig loaded: MY_ENV format: ","time":"2000-03-08T10:49:12Z"}
+// {"file":"/project/internal/server/server.go:36","function":"xxx","level":"trace","msg":"msgconfig loaded: MY_ENV format: ","time":"2000-03-08T10:49:12Z"}
 //
-// {"file":"/project/internal/pkg/logs/kratos.go:34","function":"xxx","level":"info","msg":"I'm godoc example message","time":"2000-03-08T10:49:12Z"}
+// {"file":"/project/internal/server/server.go:34","function":"xxx","level":"info","msg":"I'm godoc example message","time":"2000-03-08T10:49:12Z"}
 func (l *LoggerKratosAdapter) Log(level log.Level, keyValPairs ...any) error {
 	if len(keyValPairs) == 0 {
 		return nil
@@ -58,7 +66,7 @@ func (l *LoggerKratosAdapter) Log(level log.Level, keyValPairs ...any) error {
 	case log.LevelInfo:
 		l.log.Info(keyValPairs...)
 	default:
-		l.log.Debug(keyValPairs...)
+		l.log.Trace(keyValPairs...)
 	}
 
 	return nil
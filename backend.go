@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+)
+
+// record is a log entry after caller fields are added and hooks have fired,
+// ready to be written by a backend.
+type record struct {
+	time    time.Time
+	level   Level
+	message string
+	// fields must not be mutated, it may be shared with the entry or the
+	// context. Call materialize to get a map that can be changed.
+	fields Fields
+	// typed are the fields added as Field, which take precedence over fields.
+	typed []Field
+	// base are the fields of the logger, see Logger.With. fields and typed
+	// take precedence over them.
+	base []Field
+	// frame is the caller, written as the "file" and "function" fields.
+	frame   *runtime.Frame
+	context context.Context
+	pc      uintptr
+}
+
+// appendFields appends all fields of the record to dst, in order of
+// precedence: base fields, fields, typed fields and then the caller fields.
+func (r *record) appendFields(dst []Field) []Field {
+	dst = append(dst, r.base...)
+	for key, value := range r.fields {
+		dst = append(dst, Any(key, value))
+	}
+	dst = append(dst, r.typed...)
+	if r.frame != nil {
+		caller := callerFields(r.frame)
+		dst = append(dst, caller[:]...)
+	}
+	return dst
+}
+
+// materialize merges the typed and caller fields of the record into a new
+// fields map, for hooks and backends that work with Fields. Values
+// implementing slog.LogValuer are resolved. Fields added by go-logger itself
+// are kept as typed fields, see addBuiltinFields.
+func (r *record) materialize() {
+	fields := make(Fields, len(r.base)+len(r.fields)+len(r.typed)+2)
+	for _, f := range r.base {
+		if f.kind != skipKind {
+			fields[f.key] = resolveField(f)
+		}
+	}
+	for key, value := range r.fields {
+		fields[key] = resolveValue(value)
+	}
+	var builtin []Field
+	for _, f := range r.typed {
+		switch f.kind {
+		case skipKind:
+		case builtinKind:
+			builtin = append(builtin, f)
+		default:
+			fields[f.key] = resolveField(f)
+		}
+	}
+	addCallerFields(fields, r.frame)
+	r.fields, r.typed, r.base, r.frame = fields, builtin, nil, nil
+}
+
+// addBuiltinFields adds the fields added by go-logger itself to the fields of
+// a materialized record, unless a field with the same key was added by the
+// user. It returns the keys of the fields that were added.
+func (r *record) addBuiltinFields() []string {
+	var added []string
+	for _, f := range r.typed {
+		if _, ok := r.fields[f.key]; !ok {
+			r.fields[f.key] = f.str
+			added = append(added, f.key)
+		}
+	}
+	return added
+}
+
+// removeBuiltinFields moves the fields with the keys returned by
+// addBuiltinFields back to the typed fields of the record, with the values
+// they have now, so they are written with the keys set with WithKeys. The
+// fields map is copied, as hooks may keep it.
+func (r *record) removeBuiltinFields(added []string) {
+	if len(added) == 0 {
+		return
+	}
+	r.fields = maps.Clone(r.fields)
+	for i, f := range r.typed {
+		if !slices.Contains(added, f.key) {
+			continue
+		}
+		value := r.fields[f.key]
+		delete(r.fields, f.key)
+		switch value := value.(type) {
+		case nil:
+			r.typed[i].kind = skipKind
+		case string:
+			r.typed[i].str = value
+		default:
+			r.typed[i].str = fmt.Sprint(value)
+		}
+	}
+}
+
+// backend writes records to the output of a Logger.
+type backend interface {
+	write(r *record)
+}
+
+// encoder serialises a record by appending it to a buffer.
+type encoder interface {
+	encode(dst []byte, r *record) []byte
+}
+
+// maxPooledBufferSize keeps the occasional huge entry from pinning memory in
+// the buffer pool.
+const maxPooledBufferSize = 64 << 10
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 1024)
+		return &buf
+	},
+}
+
+// encoderBackend encodes records into pooled buffers and writes them to the
+// output of a Logger.
+type encoderBackend struct {
+	core    *core
+	encoder encoder
+	// config is the configuration encoder was built from.
+	config encoderConfig
+}
+
+func (b *encoderBackend) write(r *record) {
+	bufPtr := bufferPool.Get().(*[]byte)
+	buf := b.encoder.encode((*bufPtr)[:0], r)
+
+	b.core.mu.Lock()
+	_, err := b.core.output.Write(buf)
+	b.core.mu.Unlock()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write to log, %v\n", err)
+	}
+
+	if cap(buf) <= maxPooledBufferSize {
+		*bufPtr = buf[:0]
+		bufferPool.Put(bufPtr)
+	}
+}
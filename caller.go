@@ -2,8 +2,10 @@ package logger
 
 import (
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 var (
@@ -19,6 +21,11 @@ var (
 
 	// Used for caller information initialisation
 	callerInitOnce sync.Once
+
+	// packages registered with RegisterHelperPackage, copied on write so
+	// looking up the caller does not take a lock
+	helperPackagesMu sync.Mutex
+	helperPackages   atomic.Pointer[[]string]
 )
 
 const (
@@ -26,8 +33,62 @@ const (
 	knownGoLoggerFrames int = 4
 )
 
-// getCaller retrieves the name of the first non-go-logger calling function
-func getCaller() *runtime.Frame {
+// RegisterHelperPackage marks pkg, e.g.
+// "github.com/myorg/myservice/internal/log", as a helper package, like
+// testing.T.Helper marks a helper function. Functions in helper packages and
+// their sub-packages are skipped when looking up the caller of an entry, so
+// entries logged through a wrapper or an adapter report the code that called
+// it. The bundled adapters register themselves.
+func RegisterHelperPackage(pkg string) {
+	pkg = strings.TrimSuffix(pkg, "/")
+	if pkg == "" {
+		return
+	}
+	helperPackagesMu.Lock()
+	defer helperPackagesMu.Unlock()
+	var packages []string
+	if p := helperPackages.Load(); p != nil {
+		packages = *p
+	}
+	if slices.Contains(packages, pkg) {
+		return
+	}
+	packages = append(packages[:len(packages):len(packages)], pkg)
+	helperPackages.Store(&packages)
+}
+
+// isHelperPackage reports whether the frames of pkg are skipped when looking up
+// the caller, because pkg is go-logger itself or a registered helper package.
+func isHelperPackage(pkg string) bool {
+	if pkg == goLoggerPackage {
+		return true
+	}
+	if p := helperPackages.Load(); p != nil {
+		for _, helper := range *p {
+			if pkg == helper || strings.HasPrefix(pkg, helper) && pkg[len(helper)] == '/' {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getCaller retrieves the name of the first non-go-logger calling function,
+// skipping skip more frames, see WithCallerSkip.
+func getCaller(skip int) *runtime.Frame {
+	var caller *runtime.Frame
+	walkCallers(maximumCallerDepth, skip, func(f runtime.Frame) bool {
+		caller = &f
+		return false
+	})
+	return caller
+}
+
+// walkCallers calls fn for the frames of the call stack, starting at the first
+// calling function outside go-logger and the helper packages, after skipping
+// skip more frames, until fn returns false. At most depth frames of the stack
+// are passed to fn.
+func walkCallers(depth, skip int, fn func(runtime.Frame) bool) {
 	// cache this package's fully-qualified name
 	callerInitOnce.Do(func() {
 		pcs := make([]uintptr, maximumCallerDepth)
@@ -36,7 +97,7 @@ func getCaller() *runtime.Frame {
 		// dynamic get the package name and the minimum caller depth
 		for i := range maximumCallerDepth {
 			funcName := runtime.FuncForPC(pcs[i]).Name()
-			if strings.Contains(funcName, "getCaller") {
+			if strings.Contains(funcName, "walkCallers") {
 				goLoggerPackage = getPackageName(funcName)
 				break
 			}
@@ -46,26 +107,37 @@ func getCaller() *runtime.Frame {
 	})
 
 	// Restrict the lookback frames to avoid runaway lookups
-	pcs := make([]uintptr, maximumCallerDepth)
-	depth := runtime.Callers(minimumCallerDepth, pcs)
-	frames := runtime.CallersFrames(pcs[:depth])
+	skip = max(skip, 0)
+	pcs := make([]uintptr, depth+skip)
+	n := runtime.Callers(minimumCallerDepth, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	emit := func(f runtime.Frame) bool {
+		if skip > 0 {
+			skip--
+			return true
+		}
+		return fn(f)
+	}
 
 	var prev runtime.Frame
+	found := false
 	for f, again := frames.Next(); again; f, again = frames.Next() {
-		pkg := getPackageName(f.Function)
-
-		// If the caller isn't part of this package, we're done
-		if pkg != goLoggerPackage {
-			if reportCallerInGoLoggerPackage {
-				return &prev
+		if !found {
+			// If the caller isn't part of this package or a helper package, we're done
+			if isHelperPackage(getPackageName(f.Function)) {
+				prev = f
+				continue
+			}
+			found = true
+			if reportCallerInGoLoggerPackage && !emit(prev) {
+				return
 			}
-			return &f //nolint:scopelint
 		}
-		prev = f
+		if !emit(f) {
+			return
+		}
 	}
-
-	// if we got here, we failed to find the caller's context
-	return nil
 }
 
 // getPackageName returns the package path part of a fully-qualified function name.
@@ -8,7 +8,7 @@ import (
 
 func Test_getCaller_initializes_vars(t *testing.T) {
 	assert.Equal(t, "", goLoggerPackage)
-	getCaller()
+	getCaller(0)
 	assert.Equal(t, "github.com/coopnorge/go-logger", goLoggerPackage)
 }
 
@@ -41,3 +41,47 @@ func Test_getPackageName(t *testing.T) {
 		})
 	}
 }
+
+func Test_isHelperPackage(t *testing.T) {
+	getCaller(0)
+	old := helperPackages.Load()
+	defer helperPackages.Store(old)
+	helperPackages.Store(nil)
+
+	RegisterHelperPackage("github.com/myorg/my-repo/log/")
+	RegisterHelperPackage("github.com/myorg/my-repo/log")
+	RegisterHelperPackage("")
+	assert.Equal(t, []string{"github.com/myorg/my-repo/log"}, *helperPackages.Load())
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "github.com/coopnorge/go-logger",
+			expected: true,
+		},
+		{
+			input:    "github.com/myorg/my-repo/log",
+			expected: true,
+		},
+		{
+			input:    "github.com/myorg/my-repo/log/internal",
+			expected: true,
+		},
+		{
+			input:    "github.com/myorg/my-repo/logging",
+			expected: false,
+		},
+		{
+			input:    "github.com/myorg/my-repo",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, isHelperPackage(testCase.input))
+		})
+	}
+}
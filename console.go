@@ -0,0 +1,224 @@
+package logger
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	consoleTimeFormat = "15:04:05.000"
+	// consoleMessageWidth is the width the message is padded to, so the
+	// caller and fields of consecutive entries line up.
+	consoleMessageWidth = 40
+)
+
+// ANSI escape codes of the colours used by the console encoder.
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorBlue   = "\x1b[34m"
+	colorCyan   = "\x1b[36m"
+	colorGray   = "\x1b[90m"
+)
+
+// consoleLevelNames are the level names written by the console encoder,
+// padded to the same width.
+var consoleLevelNames = [...]string{
+	LevelFatal: "FATAL",
+	LevelError: "ERROR",
+	LevelWarn:  "WARN ",
+	LevelInfo:  "INFO ",
+	LevelDebug: "DEBUG",
+	LevelTrace: "TRACE",
+	LevelPanic: "PANIC",
+}
+
+var consoleLevelColors = [...]string{
+	LevelFatal: colorRed,
+	LevelError: colorRed,
+	LevelWarn:  colorYellow,
+	LevelInfo:  colorGreen,
+	LevelDebug: colorBlue,
+	LevelTrace: colorGray,
+	LevelPanic: colorRed,
+}
+
+// consoleEncoder writes a record as a line of human-readable text with the
+// level, time, message, short caller and the fields sorted by key. The time
+// of day is written, unless a time format is set. Fields with multi-line
+// values, like errors with a stack trace, are written indented below the
+// line.
+type consoleEncoder struct {
+	enc   *encoding
+	color bool
+}
+
+// consoleBlock is a field with a multi-line value, written below the line.
+type consoleBlock struct {
+	key, value string
+}
+
+func (e consoleEncoder) encode(dst []byte, r *record) []byte {
+	enc := e.enc.orDefault()
+	fieldsPtr := fieldsPool.Get().(*[]Field)
+	fields := r.appendFields((*fieldsPtr)[:0])
+
+	var caller string
+	for i := len(fields) - 1; i >= 0; i-- {
+		if fields[i].key == fileKey {
+			file, _ := consoleString(fields[i])
+			caller = shortCaller(file)
+			break
+		}
+	}
+	fields = slices.DeleteFunc(fields, func(f Field) bool {
+		return f.key == fileKey || f.key == functionKey
+	})
+	fields = sortFields(enc.renameFields(fields, false))
+
+	name, color := "?????", ""
+	if int(r.level) < len(consoleLevelNames) {
+		name, color = consoleLevelNames[r.level], consoleLevelColors[r.level]
+	}
+	dst = e.appendColored(dst, color, name)
+	dst = append(dst, ' ')
+	if enc.timeFormat == "" {
+		dst = e.appendColored(dst, colorGray, r.time.Format(consoleTimeFormat))
+	} else {
+		dst = e.appendColored(dst, colorGray, string(enc.appendTime(nil, r.time)))
+	}
+	dst = append(dst, ' ')
+	dst = append(dst, r.message...)
+	if caller != "" || len(fields) > 0 {
+		dst = append(dst, ' ')
+		for n := utf8.RuneCountInString(r.message); n < consoleMessageWidth; n++ {
+			dst = append(dst, ' ')
+		}
+	}
+	if caller != "" {
+		dst = e.appendColored(dst, colorGray, caller)
+	}
+
+	var blocks []consoleBlock
+	dst = e.appendFields(dst, "", fields, &blocks)
+	for _, block := range blocks {
+		dst = append(dst, "\n  "...)
+		dst = e.appendColored(dst, colorCyan, block.key)
+		dst = append(dst, ':')
+		for line := range strings.Lines(strings.TrimRight(block.value, "\n")) {
+			dst = append(dst, "\n    "...)
+			dst = append(dst, strings.TrimSuffix(line, "\n")...)
+		}
+	}
+	dst = append(dst, '\n')
+
+	clear(fields)
+	*fieldsPtr = fields[:0]
+	fieldsPool.Put(fieldsPtr)
+	return dst
+}
+
+// appendFields appends fields as key=value pairs, with the keys of objects
+// and nested Fields flattened to dotted keys. Multi-line values are added to
+// blocks instead.
+func (e consoleEncoder) appendFields(dst []byte, prefix string, fields []Field, blocks *[]consoleBlock) []byte {
+	for _, f := range fields {
+		f = resolved(f)
+		key := prefix + f.key
+		if members, ok := nestedFields(f); ok {
+			dst = e.appendFields(dst, key+".", members, blocks)
+			continue
+		}
+
+		value, text := consoleString(f)
+		if text && strings.Contains(strings.TrimRight(value, "\n"), "\n") {
+			*blocks = append(*blocks, consoleBlock{key: key, value: value})
+			continue
+		}
+		dst = append(dst, ' ')
+		dst = e.appendColored(dst, colorCyan, key)
+		dst = append(dst, '=')
+		if text {
+			dst = appendConsoleString(dst, value)
+		} else {
+			dst = append(dst, value...)
+		}
+	}
+	return dst
+}
+
+// consoleString returns the value of f as written by the console encoder.
+// text reports whether the value is text, like a string or an error, which is
+// quoted when needed. Other values are written as JSON.
+func consoleString(f Field) (value string, text bool) {
+	switch f.kind {
+	case stringKind, builtinKind:
+		return f.str, true
+	case errorKind:
+		return f.value.(error).Error(), true
+	case durationKind:
+		return time.Duration(f.integer).String(), true
+	case timeKind:
+		return time.Unix(0, f.integer).In(f.value.(*time.Location)).Format(time.RFC3339Nano), true
+	case callerFileKind:
+		return f.str + ":" + strconv.FormatInt(f.integer, 10), true
+	case anyKind:
+		switch v := resolveValue(f.value).(type) {
+		case string:
+			return v, true
+		case error:
+			return v.Error(), true
+		case time.Duration:
+			return v.String(), true
+		case time.Time:
+			return v.Format(time.RFC3339Nano), true
+		default:
+			return string(appendJSONValue(nil, v)), false
+		}
+	}
+	return string(appendJSONField(nil, f)), false
+}
+
+// appendConsoleString appends s, quoted when it is empty or contains spaces,
+// quotes, '=' or non-printable characters.
+func appendConsoleString(dst []byte, s string) []byte {
+	if s == "" || needsQuoting(s) {
+		return strconv.AppendQuote(dst, s)
+	}
+	return append(dst, s...)
+}
+
+func needsQuoting(s string) bool {
+	return strings.ContainsFunc(s, func(r rune) bool {
+		return r == '"' || r == '=' || unicode.IsSpace(r) || !unicode.IsPrint(r)
+	})
+}
+
+// shortCaller returns the last directory, file name and line of a caller,
+// e.g. "go-logger/entry.go:42".
+func shortCaller(file string) string {
+	i := strings.LastIndexByte(file, '/')
+	if i <= 0 {
+		return file
+	}
+	if j := strings.LastIndexByte(file[:i], '/'); j >= 0 {
+		return file[j+1:]
+	}
+	return file
+}
+
+// appendColored appends s in color, when colours are enabled.
+func (e consoleEncoder) appendColored(dst []byte, color, s string) []byte {
+	if !e.color || color == "" {
+		return append(dst, s...)
+	}
+	dst = append(dst, color...)
+	dst = append(dst, s...)
+	return append(dst, colorReset...)
+}
@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsoleEncoder(t *testing.T) {
+	frame := &runtime.Frame{File: "/app/internal/payments/capture.go", Line: 42, Function: "payments.Capture"}
+
+	testCases := map[string]struct {
+		record   *record
+		color    bool
+		expected string
+	}{
+		"message only": {
+			record:   &record{level: LevelInfo, message: "foobar"},
+			expected: "INFO  10:10:10.001 foobar\n",
+		},
+		"caller": {
+			record:   &record{level: LevelWarn, message: "foobar", frame: frame},
+			expected: "WARN  10:10:10.001 foobar                                   payments/capture.go:42\n",
+		},
+		"materialized caller": {
+			record:   &record{level: LevelWarn, message: "foobar", fields: Fields{"file": "/app/main.go:7", "function": "main.main"}},
+			expected: "WARN  10:10:10.001 foobar                                   app/main.go:7\n",
+		},
+		"sorted fields": {
+			record: &record{level: LevelDebug, message: "foobar", fields: Fields{"b": "two words", "a": 1}, typed: []Field{
+				Duration("elapsed", 1500*time.Millisecond),
+				String("empty", ""),
+				Bool("ok", true),
+				Any("list", []int{1, 2}),
+				Any("quote", `say "hi"`),
+			}},
+			expected: `DEBUG 10:10:10.001 foobar                                    a=1 b="two words" elapsed=1.5s empty="" list=[1,2] ok=true quote="say \"hi\""` + "\n",
+		},
+		"nested fields": {
+			record: &record{level: LevelInfo, message: "foobar", fields: Fields{"user": Fields{"name": "peter", "id": 7}}, typed: []Field{
+				Object("order", String("id", "abc"), Int64("amount", 42)),
+			}},
+			expected: "INFO  10:10:10.001 foobar                                    order.amount=42 order.id=abc user.id=7 user.name=peter\n",
+		},
+		"multi-line error": {
+			record: &record{level: LevelError, message: "foobar", typed: []Field{
+				Err(errors.New("first line\nsecond line")),
+				String("stacktrace", "main.main\n\t/app/main.go:7\n"),
+				String("a", "b"),
+			}},
+			expected: "ERROR 10:10:10.001 foobar                                    a=b\n" +
+				"  error:\n    first line\n    second line\n" +
+				"  stacktrace:\n    main.main\n    \t/app/main.go:7\n",
+		},
+		"color": {
+			record:   &record{level: LevelError, message: "foobar", frame: frame, typed: []Field{String("a", "b")}},
+			color:    true,
+			expected: "\x1b[31mERROR\x1b[0m \x1b[90m10:10:10.001\x1b[0m foobar                                   \x1b[90mpayments/capture.go:42\x1b[0m \x1b[36ma\x1b[0m=b\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.record.time = mockNowFunc()
+			assert.Equal(t, tc.expected, string(consoleEncoder{color: tc.color}.encode(nil, tc.record)))
+		})
+	}
+}
+
+func TestConsoleFormat(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithFormat(FormatConsole), WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	logger.WithError(fmt.Errorf("capture payment: %w", errors.New("card declined"))).Warnw("payment failed", "order_id", 42)
+	assert.Equal(t, `WARN  10:10:10.001 payment failed                            error="capture payment: card declined" order_id=42`+"\n", buf.String())
+}
+
+func Test_shortCaller(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "/app/internal/payments/capture.go:42",
+			expected: "payments/capture.go:42",
+		},
+		{
+			input:    "/main.go:7",
+			expected: "/main.go:7",
+		},
+		{
+			input:    "main.go:7",
+			expected: "main.go:7",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, shortCaller(testCase.input))
+		})
+	}
+}
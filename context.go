@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"context"
+	"maps"
+)
+
+type (
+	levelContextKey  struct{}
+	fieldsContextKey struct{}
+	entryContextKey  struct{}
+)
+
+// ContextWithLevel returns a copy of ctx that lowers the minimum level for
+// entries logged with it. Entries given the context with WithContext are
+// logged if they pass either the level of the logger or level, so a single
+// request can be logged at debug level without changing the level of the
+// whole service. Loggers at LevelOff still log nothing.
+func ContextWithLevel(ctx context.Context, level Level) context.Context {
+	return context.WithValue(ctx, levelContextKey{}, level)
+}
+
+// LevelFromContext returns the level set with ContextWithLevel, if any.
+func LevelFromContext(ctx context.Context) (Level, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	level, ok := ctx.Value(levelContextKey{}).(Level)
+	return level, ok
+}
+
+// enabledByContext reports whether ctx forces entries at level to be logged.
+func enabledByContext(ctx context.Context, level Level) bool {
+	ctxLevel, ok := LevelFromContext(ctx)
+	return ok && ctxLevel.Enables(level)
+}
+
+// ContextWithFields returns a copy of ctx that carries fields, merged with any
+// fields ctx already carries. Entries given the context with WithContext get
+// the fields added when they are logged, so request scoped values like a
+// request id only have to be set once.
+//
+// Fields set explicitly on an entry or logger, e.g. with WithField or
+// Logger.With, take precedence over fields from the context. Fields from later
+// calls to ContextWithFields take precedence over fields from earlier calls.
+// Hooks see and can change all fields.
+func ContextWithFields(ctx context.Context, fields Fields) context.Context {
+	parent := contextFields(ctx)
+	merged := make(Fields, len(parent)+len(fields))
+	maps.Copy(merged, parent)
+	maps.Copy(merged, fields)
+	return context.WithValue(ctx, fieldsContextKey{}, merged)
+}
+
+// FieldsFromContext returns a copy of the fields set with ContextWithFields.
+func FieldsFromContext(ctx context.Context) Fields {
+	return maps.Clone(contextFields(ctx))
+}
+
+// contextFields returns the fields set with ContextWithFields. The returned
+// fields must not be modified.
+func contextFields(ctx context.Context) Fields {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsContextKey{}).(Fields)
+	return fields
+}
+
+// NewContext returns a copy of ctx that carries l, a *Logger or an *Entry, to
+// be retrieved with FromContext. Middleware can use it to attach a request
+// scoped entry, e.g. with a request id and route, for downstream code to log
+// through.
+func NewContext[L *Logger | *Entry](ctx context.Context, l L) context.Context {
+	var e *Entry
+	switch l := any(l).(type) {
+	case *Logger:
+		e = l.entry()
+	case *Entry:
+		e = l
+	}
+	return context.WithValue(ctx, entryContextKey{}, e)
+}
+
+// FromContext returns an entry for the logger or entry stored in ctx with
+// NewContext, or for the global logger if there is none. The entry has ctx
+// set as its context, as if WithContext(ctx) was called, so hooks see ctx
+// rather than the context the entry was stored from. A nil ctx returns an
+// entry for the global logger.
+func FromContext(ctx context.Context) *Entry {
+	if ctx == nil {
+		return globalLogger.entry()
+	}
+	if e, ok := ctx.Value(entryContextKey{}).(*Entry); ok && e != nil {
+		return e.WithContext(ctx)
+	}
+	return globalLogger.WithContext(ctx)
+}
@@ -0,0 +1,227 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextWithLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelWarn))
+	ctx := ContextWithLevel(context.Background(), LevelInfo)
+
+	logger.WithContext(ctx).Info("forced by context")
+	assertLogEntryContains(t, buf, "msg", "forced by context")
+
+	logger.WithContext(ctx).Debug("below context level")
+	assert.False(t, wasLogged(t, buf))
+
+	logger.Info("without context")
+	assert.False(t, wasLogged(t, buf))
+
+	logger.WithContext(context.Background()).Info("context without level")
+	assert.False(t, wasLogged(t, buf))
+
+	// The context can only lower the minimum level
+	logger.WithContext(ContextWithLevel(context.Background(), LevelError)).Warn("passes logger level")
+	assertLogEntryContains(t, buf, "msg", "passes logger level")
+}
+
+func TestContextWithLevelOnLoggerAtLevelOff(t *testing.T) {
+	buf := &bytes.Buffer{}
+	fired := false
+	logger := New(WithOutput(buf), WithLevel(LevelOff), WithHookFunc(func(*HookEntry) (bool, error) {
+		fired = true
+		return false, nil
+	}))
+
+	logger.WithContext(ContextWithLevel(context.Background(), LevelDebug)).Error("foobar")
+	assert.False(t, wasLogged(t, buf))
+	assert.False(t, fired)
+}
+
+func TestContextWithLevelInChildAndSlogLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelWarn))
+	ctx := ContextWithLevel(context.Background(), LevelDebug)
+
+	logger.Named("billing").WithContext(ctx).Debugf("child %d", 1)
+	assertLogEntryContains(t, buf, "msg", "child 1")
+
+	sl := logger.Slog()
+	assert.True(t, sl.Enabled(ctx, slog.LevelDebug))
+	sl.DebugContext(ctx, "slog")
+	assertLogEntryContains(t, buf, "msg", "slog")
+	sl.Debug("slog without context")
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestLevelFromContext(t *testing.T) {
+	_, ok := LevelFromContext(nil) //nolint:staticcheck // nil context is handled
+	assert.False(t, ok)
+
+	_, ok = LevelFromContext(context.Background())
+	assert.False(t, ok)
+
+	level, ok := LevelFromContext(ContextWithLevel(context.Background(), LevelDebug))
+	assert.True(t, ok)
+	assert.Equal(t, LevelDebug, level)
+}
+
+func TestLevelMiddleware(t *testing.T) {
+	testCases := map[string]struct {
+		header        string
+		expectedLevel Level
+		expectedOK    bool
+	}{
+		"no header":       {header: "", expectedOK: false},
+		"unknown level":   {header: "verbose", expectedOK: false},
+		"debug":           {header: "debug", expectedLevel: LevelDebug, expectedOK: true},
+		"case is ignored": {header: "INFO", expectedLevel: LevelInfo, expectedOK: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				level Level
+				ok    bool
+			)
+			next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				level, ok = LevelFromContext(r.Context())
+			})
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				req.Header.Set("X-Debug-Log-Level", tc.header)
+			}
+
+			NewLevelMiddleware("X-Debug-Log-Level")(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedLevel, level)
+		})
+	}
+}
+
+func TestContextWithFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo))
+
+	ctx := ContextWithFields(context.Background(), Fields{"request_id": "abc", "user_id": 1, "tenant": "coop"})
+	ctx = ContextWithFields(ctx, Fields{"user_id": 2})
+
+	logger.WithContext(ctx).Info("foobar")
+	b := buf.Bytes()
+	assertLogEntryContains(t, bytes.NewReader(b), "request_id", "abc")
+	assertLogEntryContains(t, bytes.NewReader(b), "user_id", float64(2))
+	assertLogEntryContains(t, bytes.NewReader(b), "tenant", "coop")
+	buf.Reset()
+
+	// Explicitly set fields take precedence, regardless of order
+	logger.With(String("tenant", "child")).WithField("request_id", "before").WithContext(ctx).WithField("user_id", 3).Info("foobar")
+	b = buf.Bytes()
+	assertLogEntryContains(t, bytes.NewReader(b), "request_id", "before")
+	assertLogEntryContains(t, bytes.NewReader(b), "user_id", float64(3))
+	assertLogEntryContains(t, bytes.NewReader(b), "tenant", "child")
+	buf.Reset()
+
+	// The original context is not modified
+	logger.WithContext(context.Background()).Info("foobar")
+	assertLogEntryDoesNotHaveKey(t, buf, "request_id")
+}
+
+func TestContextWithFieldsVisibleToHooks(t *testing.T) {
+	buf := &bytes.Buffer{}
+	hook := func(he *HookEntry) (bool, error) {
+		he.Data["from_hook"] = he.Data["request_id"]
+		return true, nil
+	}
+	logger := New(WithOutput(buf), WithHookFunc(hook))
+
+	logger.WithContext(ContextWithFields(context.Background(), Fields{"request_id": "abc"})).Error("foobar")
+	assertLogEntryContains(t, buf, "from_hook", "abc")
+}
+
+func TestContextWithFieldsInSlogLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo))
+
+	ctx := ContextWithFields(context.Background(), Fields{"request_id": "abc"})
+	logger.Slog().InfoContext(ctx, "foobar")
+	assertLogEntryContains(t, buf, "request_id", "abc")
+}
+
+func TestFieldsFromContext(t *testing.T) {
+	assert.Nil(t, FieldsFromContext(context.Background()))
+
+	ctx := ContextWithFields(context.Background(), Fields{"request_id": "abc"})
+	fields := FieldsFromContext(ctx)
+	assert.Equal(t, Fields{"request_id": "abc"}, fields)
+
+	// Modifying the returned fields does not affect the context
+	fields["request_id"] = "modified"
+	assert.Equal(t, Fields{"request_id": "abc"}, FieldsFromContext(ctx))
+}
+
+func TestNewContextWithEntry(t *testing.T) {
+	ctx := context.WithValue(context.Background(), myCtxKey{}, "my-custom-ctx-value")
+
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo), WithHookFunc(testHook))
+	ctx = NewContext(ctx, logger.WithFields(Fields{"request_id": "abc", "route": "/invoices"}))
+
+	FromContext(ctx).Info("foobar")
+	b := buf.Bytes()
+	assertLogEntryContains(t, bytes.NewReader(b), "request_id", "abc")
+	assertLogEntryContains(t, bytes.NewReader(b), "route", "/invoices")
+	assertLogEntryContains(t, bytes.NewReader(b), "my-custom-log-key", "my-custom-ctx-value")
+}
+
+func TestNewContextWithLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo))
+	ctx := NewContext(context.Background(), logger.Named("billing"))
+
+	FromContext(ctx).Info("foobar")
+	assertLogEntryContains(t, buf, "logger", "billing")
+}
+
+func TestFromContextUsesLatestContext(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo), WithHookFunc(testHook))
+
+	ctx := NewContext(context.Background(), logger.WithContext(context.Background()))
+	ctx = context.WithValue(ctx, myCtxKey{}, "added-after-new-context")
+	ctx = ContextWithFields(ctx, Fields{"user_id": 1})
+
+	FromContext(ctx).Info("foobar")
+	b := buf.Bytes()
+	assertLogEntryContains(t, bytes.NewReader(b), "my-custom-log-key", "added-after-new-context")
+	assertLogEntryContains(t, bytes.NewReader(b), "user_id", float64(1))
+}
+
+func TestFromContextFallsBackToGlobalLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	oldOutput := globalLogger.output
+	defer func() {
+		// bring global logger to original state after tests
+		ConfigureGlobalLogger(WithOutput(oldOutput))
+	}()
+	ConfigureGlobalLogger(WithOutput(buf))
+
+	entry := FromContext(context.Background())
+	assert.Same(t, Global(), entry.logger)
+
+	entry.Warn("foobar")
+	assertLogEntryContains(t, buf, "msg", "foobar")
+	buf.Reset()
+
+	entry = FromContext(nil) //nolint:staticcheck // nil context is handled
+	assert.Same(t, Global(), entry.logger)
+	entry.Warn("foobar")
+	assertLogEntryContains(t, buf, "msg", "foobar")
+}
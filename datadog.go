@@ -0,0 +1,51 @@
+package logger
+
+// datadogKeys are the Datadog reserved attributes, see
+// https://docs.datadoghq.com/logs/log_configuration/attributes_naming_convention/.
+var datadogKeys = Keys{
+	Message: "message",
+	Level:   "status",
+	Name:    "logger.name",
+	Service: "service",
+	Env:     "dd.env",
+	Version: "dd.version",
+	TraceID: "dd.trace_id",
+	SpanID:  "dd.span_id",
+}
+
+// datadogStatuses are the statuses of the levels. Datadog does not recognise
+// "panic", so panics are written as emergency, the status Datadog maps
+// "fatal" to.
+var datadogStatuses = levelNames{
+	LevelFatal: "fatal",
+	LevelError: "error",
+	LevelWarn:  "warning",
+	LevelInfo:  "info",
+	LevelDebug: "debug",
+	LevelTrace: "trace",
+	LevelPanic: "emergency",
+}
+
+// WithDatadogProfile writes entries with the reserved attributes of Datadog:
+// the message as message, the level as status (emergency for LevelPanic), the
+// service, env and version as service, dd.env and dd.version, the name given
+// with Named as logger.name and the error as error.kind, error.message and
+// error.stack. Trace ids returned by the function set with
+// WithTraceContextFunc are written as dd.trace_id and dd.span_id, so entries
+// are correlated with traces.
+//
+//	logger.ConfigureGlobalLogger(logger.WithDatadogProfile("payments", "production", "1.2.3"))
+func WithDatadogProfile(service, env, version string) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		for _, opt := range []LoggerOption{
+			WithKeys(datadogKeys),
+			WithService(service, env, version),
+			WithErrorFields(true),
+		} {
+			opt.Apply(l)
+		}
+		l.levelNames = &datadogStatuses
+		// Undo the parts of WithGoogleCloudProfile that WithKeys does not.
+		l.sourceLocation, l.traceIDPrefix = "", ""
+	})
+}
@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDatadogProfile(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithDatadogProfile("payments", "production", "1.2.3"), WithTraceContextFunc(testTraceContext))
+	ctx := context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})
+
+	logger.Named("billing").WithContext(ctx).WithError(errors.New("card declined")).Error("payment failed")
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, "payment failed", log["message"])
+	assert.Equal(t, "error", log["status"])
+	assert.Equal(t, "payments", log["service"])
+	assert.Equal(t, map[string]any{"env": "production", "version": "1.2.3", "trace_id": "123", "span_id": "456"}, log["dd"])
+	assert.Equal(t, map[string]any{"name": "billing"}, log["logger"])
+	assert.Equal(t, map[string]any{"kind": "*errors.errorString", "message": "card declined"}, log["error"])
+	assert.Equal(t, "github.com/coopnorge/go-logger.TestWithDatadogProfile", log["function"])
+	assert.NotContains(t, log, "msg")
+	assert.NotContains(t, log, "level")
+}
+
+func TestWithDatadogProfileReplacedByWithKeys(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithDatadogProfile("payments", "", ""), WithKeys(Keys{Message: "msg"}))
+
+	logger.Warn("foobar")
+	assert.Equal(t, `{"level":"warning","msg":"foobar","service":"payments","time":"2020-10-10T10:10:10.001Z"}`+"\n", buf.String())
+}
+
+func TestWithDatadogProfileKeepsUserFields(t *testing.T) {
+	ctx := context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})
+	expected := `{"dd":{"env":"production","span_id":"456","trace_id":"123","version":"1.2.3"},"env":"customer-env","message":"foobar","service":"payments","span_id":"user-span","status":"warning","time":"2020-10-10T10:10:10.001Z","version":"api-v2"}` + "\n"
+
+	testCases := map[string][]LoggerOption{
+		"without hooks": nil,
+		"with hooks": {WithHookFunc(func(*HookEntry) (bool, error) {
+			return false, nil
+		})},
+	}
+	for name, opts := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			opts = append([]LoggerOption{WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false),
+				WithDatadogProfile("payments", "production", "1.2.3"), WithTraceContextFunc(testTraceContext)}, opts...)
+			logger := New(opts...)
+
+			logger.WithContext(ctx).WithFields(Fields{"version": "api-v2", "env": "customer-env"}).
+				LogFields(LevelWarn, "foobar", String("span_id", "user-span"))
+			assert.Equal(t, expected, buf.String())
+		})
+	}
+}
+
+func TestWithDatadogProfileHookChangesTraceFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithDatadogProfile("", "", ""),
+		WithTraceContextFunc(testTraceContext), WithHookFunc(func(e *HookEntry) (bool, error) {
+			e.Data["trace_id"] = "changed"
+			delete(e.Data, "span_id")
+			return true, nil
+		}))
+
+	logger.WithContext(context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})).Warn("foobar")
+	assert.Equal(t, `{"dd":{"trace_id":"changed"},"message":"foobar","status":"warning","time":"2020-10-10T10:10:10.001Z"}`+"\n", buf.String())
+}
+
+func TestWithDatadogProfileStatuses(t *testing.T) {
+	testCases := map[Level]string{
+		LevelTrace: "trace",
+		LevelDebug: "debug",
+		LevelInfo:  "info",
+		LevelWarn:  "warning",
+		LevelError: "error",
+		LevelFatal: "fatal",
+		LevelPanic: "emergency",
+	}
+	for level, expected := range testCases {
+		t.Run(level.String(), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithLevel(LevelTrace), WithDatadogProfile("", "", ""), WithExitFunc(func(int) {}))
+			func() {
+				defer func() { _ = recover() }()
+				logger.Log(level, "foobar")
+			}()
+			assert.Equal(t, expected, decodeLogToMap(t, buf)["status"])
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package e2etests
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	logger "github.com/coopnorge/go-logger"
+	gormAdapter "github.com/coopnorge/go-logger/adapter/gorm"
+	kratosAdapter "github.com/coopnorge/go-logger/adapter/kratos"
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestGetCaller_GormAdapter(t *testing.T) {
+	// This function tests that entries logged through gorm report the code that called gorm.
+
+	builder := &strings.Builder{}
+	gormLogger, err := gormAdapter.NewLogger(
+		gormAdapter.WithLogger(logger.New(logger.WithOutput(builder), logger.WithLevel(logger.LevelTrace))),
+		gormAdapter.WithSQLTrace(),
+	)
+	require.NoError(t, err)
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: gormLogger})
+	require.NoError(t, err)
+	builder.Reset()
+
+	result := db.Exec("SELECT 1")
+	_, _, line, ok := runtime.Caller(0) // Important: Run this IMMEDIATELY after the db.Exec line
+	require.True(t, ok)
+	expectedLine := line - 1 // We want the log to contain the line where db.Exec was called
+	require.NoError(t, result.Error)
+
+	logJSON := map[string]any{}
+	err = json.Unmarshal([]byte(builder.String()), &logJSON)
+	require.NoError(t, err)
+
+	assert.Contains(t, logJSON["file"], fmt.Sprintf("e2e_tests/adapter_caller_test.go:%d", expectedLine))
+	assert.Equal(t, "github.com/coopnorge/go-logger-e2e-tests.TestGetCaller_GormAdapter", logJSON["function"])
+}
+
+func TestGetCaller_KratosAdapter(t *testing.T) {
+	// This function tests that entries logged through a kratos helper report the code that called the helper.
+
+	builder := &strings.Builder{}
+	helper := log.NewHelper(kratosAdapter.NewLoggerKratosAdapter(logger.New(logger.WithOutput(builder), logger.WithLevel(logger.LevelDebug))))
+
+	helper.Info("Hello")
+	_, _, line, ok := runtime.Caller(0) // Important: Run this IMMEDIATELY after the log line
+	require.True(t, ok)
+	expectedLine := line - 1 // We want the log to contain the line where helper.Info was called
+
+	logJSON := map[string]any{}
+	err := json.Unmarshal([]byte(builder.String()), &logJSON)
+	require.NoError(t, err)
+
+	assert.Contains(t, logJSON["file"], fmt.Sprintf("e2e_tests/adapter_caller_test.go:%d", expectedLine))
+	assert.Equal(t, "github.com/coopnorge/go-logger-e2e-tests.TestGetCaller_KratosAdapter", logJSON["function"])
+}
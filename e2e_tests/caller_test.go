@@ -3,11 +3,13 @@ package e2etests
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"runtime"
 	"strings"
 	"testing"
 
 	logger "github.com/coopnorge/go-logger"
+	"github.com/coopnorge/go-logger-e2e-tests/internal/applog"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -118,3 +120,80 @@ func TestGetCaller_Logf(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, "github.com/coopnorge/go-logger-e2e-tests.TestGetCaller_Logf", function)
 }
+
+func TestStacktraceStartsAtCaller(t *testing.T) {
+	// This function tests that the stack trace starts at the line the log was called from,
+	// both when logging through go-logger and through slog.
+
+	testCases := map[string]func(l *logger.Logger){
+		"Error": func(l *logger.Logger) { l.Error("Hello") },
+		"slog":  func(l *logger.Logger) { slog.New(logger.NewSlogHandler(l)).Error("Hello") },
+	}
+	for name, log := range testCases {
+		t.Run(name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			l := logger.New(logger.WithOutput(builder), logger.WithStacktraceLevel(logger.LevelError), logger.WithStacktraceFormat(logger.StacktraceFrames))
+
+			log(l)
+
+			logJSON := map[string]any{}
+			err := json.Unmarshal([]byte(builder.String()), &logJSON)
+			require.NoError(t, err)
+
+			frames, ok := logJSON["stacktrace"].([]any)
+			require.True(t, ok)
+			require.GreaterOrEqual(t, len(frames), 2)
+			first, ok := frames[0].(map[string]any)
+			require.True(t, ok)
+			// The first frame is the caller reported in the file and function fields.
+			assert.Equal(t, logJSON["function"], first["function"])
+			assert.Equal(t, logJSON["file"], fmt.Sprintf("%s:%v", first["file"], first["line"]))
+			second, ok := frames[1].(map[string]any)
+			require.True(t, ok)
+			assert.Regexp(t, `^github\.com/coopnorge/go-logger-e2e-tests\.TestStacktraceStartsAtCaller\.func\d+$`, second["function"])
+		})
+	}
+}
+
+// logInfo is a wrapper for the logger, skipped with WithCallerSkip(1).
+func logInfo(l *logger.Logger, msg string) {
+	l.Info(msg)
+}
+
+func TestGetCaller_WithCallerSkip(t *testing.T) {
+	// This function tests that the caller of a wrapper function is reported when skipping its frame.
+
+	builder := &strings.Builder{}
+	l := logger.New(logger.WithOutput(builder), logger.WithLevel(logger.LevelDebug), logger.WithCallerSkip(1))
+
+	logInfo(l, "Hello")
+	_, _, line, ok := runtime.Caller(0) // Important: Run this IMMEDIATELY after the log line
+	require.True(t, ok)
+	expectedLine := line - 1 // We want the log to contain the line where logInfo was called
+
+	logJSON := map[string]any{}
+	err := json.Unmarshal([]byte(builder.String()), &logJSON)
+	require.NoError(t, err)
+
+	assert.Contains(t, logJSON["file"], fmt.Sprintf("e2e_tests/caller_test.go:%d", expectedLine))
+	assert.Equal(t, "github.com/coopnorge/go-logger-e2e-tests.TestGetCaller_WithCallerSkip", logJSON["function"])
+}
+
+func TestGetCaller_RegisterHelperPackage(t *testing.T) {
+	// This function tests that the frames of a registered helper package are skipped.
+
+	builder := &strings.Builder{}
+	l := logger.New(logger.WithOutput(builder), logger.WithLevel(logger.LevelDebug))
+
+	applog.Info(l, "Hello")
+	_, _, line, ok := runtime.Caller(0) // Important: Run this IMMEDIATELY after the log line
+	require.True(t, ok)
+	expectedLine := line - 1 // We want the log to contain the line where applog.Info was called
+
+	logJSON := map[string]any{}
+	err := json.Unmarshal([]byte(builder.String()), &logJSON)
+	require.NoError(t, err)
+
+	assert.Contains(t, logJSON["file"], fmt.Sprintf("e2e_tests/caller_test.go:%d", expectedLine))
+	assert.Equal(t, "github.com/coopnorge/go-logger-e2e-tests.TestGetCaller_RegisterHelperPackage", logJSON["function"])
+}
@@ -0,0 +1,20 @@
+// Package applog is a wrapper around go-logger, registered as a helper package
+// so entries report the code that called it.
+package applog
+
+import (
+	logger "github.com/coopnorge/go-logger"
+)
+
+func init() {
+	logger.RegisterHelperPackage("github.com/coopnorge/go-logger-e2e-tests/internal/applog")
+}
+
+// Info logs msg at info level through l.
+func Info(l *logger.Logger, msg string) {
+	info(l, msg)
+}
+
+func info(l *logger.Logger, msg string) {
+	l.Info(msg)
+}
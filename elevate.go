@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+// elevation is the state of a temporary level set with Logger.ElevateLevel.
+type elevation struct {
+	mu sync.Mutex
+	// id identifies the active elevation, so that a stale revert does not
+	// end a newer elevation.
+	id    uint64
+	timer *time.Timer
+	// previous and elevated are the raw values of the levelVar before and
+	// during the elevation.
+	previous uint32
+	elevated uint32
+}
+
+// ElevateLevel sets the minimum level of the logger to level for the duration
+// d, and then restores the level the logger had before. An info entry is
+// logged both when the elevation starts and when it ends, whatever the level
+// of the logger, unless it is LevelOff. The returned function ends the
+// elevation early.
+//
+// Elevating a child logger only affects that logger and the loggers derived
+// from it. Calling ElevateLevel again while an elevation is active replaces it,
+// and the level from before the first elevation is restored when it ends. If
+// the level is changed with SetLevel during the elevation, it is kept.
+func (logger *Logger) ElevateLevel(level Level, d time.Duration) (cancel func()) {
+	e := &logger.elevation
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	previous := logger.Level()
+	if e.timer != nil {
+		e.timer.Stop()
+	} else {
+		e.previous = logger.level.v.Load()
+	}
+	logger.level.set(level)
+	e.elevated = logger.level.v.Load()
+	e.id++
+	id := e.id
+	e.timer = time.AfterFunc(d, func() { logger.endElevation(id) })
+
+	logger.WithFields(Fields{
+		"previous_level": previous.String(),
+		"elevated_level": level.String(),
+		"duration":       d.String(),
+	}).logElevation("Log level elevated")
+
+	return func() { logger.endElevation(id) }
+}
+
+// endElevation ends the elevation with the given id, if it is still active.
+func (logger *Logger) endElevation(id uint64) {
+	e := &logger.elevation
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.timer == nil || e.id != id {
+		return
+	}
+	e.timer.Stop()
+	e.timer = nil
+
+	// Log before restoring, so the entry is written at the elevated level.
+	logger.entry().logElevation("Log level elevation ended")
+	logger.level.v.CompareAndSwap(e.elevated, e.previous)
+}
+
+// logElevation writes an info entry about an elevation regardless of the
+// level of the logger, unless it is LevelOff.
+func (e *Entry) logElevation(msg string) {
+	if e.logger.Level() != LevelOff {
+		e.write(LevelInfo, e.callerFrame(), msg)
+	}
+}
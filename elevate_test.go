@@ -0,0 +1,187 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElevateLevel(t *testing.T) {
+	builder := &strings.Builder{}
+	logger := New(WithOutput(builder), WithReportCaller(false))
+
+	cancel := logger.ElevateLevel(LevelDebug, time.Hour)
+	assert.Equal(t, LevelDebug, logger.Level())
+	logger.Debug("while elevated")
+	cancel()
+	assert.Equal(t, LevelWarn, logger.Level())
+	logger.Debug("after elevation")
+	cancel() // ending an elevation twice is a no-op
+
+	lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+	require.Len(t, lines, 3)
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "msg", "Log level elevated")
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "level", "info")
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "previous_level", "warn")
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "elevated_level", "debug")
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "duration", "1h0m0s")
+	assertLogEntryContains(t, strings.NewReader(lines[1]), "msg", "while elevated")
+	assertLogEntryContains(t, strings.NewReader(lines[2]), "msg", "Log level elevation ended")
+	assertLogEntryContains(t, strings.NewReader(lines[2]), "level", "info")
+}
+
+func TestElevateLevelLogsStartAndEndAtAnyLevel(t *testing.T) {
+	testCases := map[string]func(logger *Logger){
+		"level below info": func(*Logger) {},
+		"level changed during elevation": func(logger *Logger) {
+			logger.SetLevel(LevelFatal)
+		},
+	}
+	for name, change := range testCases {
+		t.Run(name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			logger := New(WithOutput(builder), WithLevel(LevelError))
+
+			cancel := logger.ElevateLevel(LevelWarn, time.Hour)
+			change(logger)
+			cancel()
+
+			lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+			require.Len(t, lines, 2)
+			assertLogEntryContains(t, strings.NewReader(lines[0]), "msg", "Log level elevated")
+			assertLogEntryContains(t, strings.NewReader(lines[1]), "msg", "Log level elevation ended")
+		})
+	}
+}
+
+func TestElevateLevelOff(t *testing.T) {
+	testCases := map[string]struct {
+		elevate  func(logger *Logger) (cancel func())
+		expected []string
+	}{
+		"elevated to off": {
+			elevate:  func(logger *Logger) func() { return logger.ElevateLevel(LevelOff, time.Hour) },
+			expected: nil,
+		},
+		"set to off during elevation": {
+			elevate: func(logger *Logger) func() {
+				cancel := logger.ElevateLevel(LevelDebug, time.Hour)
+				logger.SetLevel(LevelOff)
+				return cancel
+			},
+			expected: []string{"Log level elevated"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithLevel(LevelOff))
+
+			tc.elevate(logger)()
+
+			var messages []string
+			for line := range strings.Lines(buf.String()) {
+				messages = append(messages, decodeLogToMap(t, strings.NewReader(line))["msg"].(string))
+			}
+			assert.Equal(t, tc.expected, messages)
+		})
+	}
+}
+
+func TestElevateLevelRevertsAutomatically(t *testing.T) {
+	logger := New(WithOutput(io.Discard))
+
+	logger.ElevateLevel(LevelDebug, 10*time.Millisecond)
+	assert.Equal(t, LevelDebug, logger.Level())
+	assert.Eventually(t, func() bool {
+		return logger.Level() == LevelWarn
+	}, time.Second, time.Millisecond)
+}
+
+func TestElevateLevelTwiceRestoresOriginalLevel(t *testing.T) {
+	logger := New(WithOutput(io.Discard), WithLevel(LevelError))
+
+	logger.ElevateLevel(LevelInfo, time.Hour)
+	cancel := logger.ElevateLevel(LevelDebug, time.Hour)
+	assert.Equal(t, LevelDebug, logger.Level())
+	cancel()
+	assert.Equal(t, LevelError, logger.Level())
+}
+
+func TestElevateLevelKeepsLevelSetDuringElevation(t *testing.T) {
+	logger := New(WithOutput(io.Discard))
+
+	cancel := logger.ElevateLevel(LevelDebug, time.Hour)
+	logger.SetLevel(LevelInfo)
+	cancel()
+	assert.Equal(t, LevelInfo, logger.Level())
+}
+
+func TestElevateLevelOfChildLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf))
+	billing := logger.Named("billing")
+	invoice := billing.Named("invoice")
+
+	cancel := billing.ElevateLevel(LevelDebug, time.Hour)
+	buf.Reset()
+
+	invoice.Debug("foobar")
+	assertLogEntryContains(t, buf, "logger", "billing.invoice")
+	logger.Debug("foobar")
+	assert.False(t, wasLogged(t, buf))
+
+	cancel()
+	buf.Reset()
+
+	// After the elevation the child follows its parent again
+	logger.SetLevel(LevelInfo)
+	billing.Info("foobar")
+	assertLogEntryContains(t, buf, "logger", "billing")
+	billing.Debug("foobar")
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestElevateLevelOfGlobalLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	oldOutput := globalLogger.output
+	oldLevel := globalLogger.Level()
+	defer func() {
+		// bring global logger to original state after tests
+		ConfigureGlobalLogger(WithOutput(oldOutput), WithLevel(oldLevel))
+	}()
+	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelWarn))
+
+	cancel := ElevateLevel(LevelDebug, time.Hour)
+	buf.Reset()
+	Debug("foobar")
+	assertLogEntryContains(t, buf, "msg", "foobar")
+
+	cancel()
+	assert.Equal(t, LevelWarn, Global().Level())
+}
+
+func TestElevateLevelWhileLogging(t *testing.T) {
+	logger := New(WithOutput(io.Discard))
+	child := logger.Named("billing")
+
+	wg := sync.WaitGroup{}
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				child.Debug("foobar")
+			}
+		})
+	}
+	for range 10 {
+		logger.ElevateLevel(LevelDebug, time.Millisecond)
+		child.ElevateLevel(LevelInfo, time.Millisecond)()
+	}
+	wg.Wait()
+}
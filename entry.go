@@ -4,14 +4,15 @@ import (
 	"context"
 	"fmt"
 	"maps"
-
-	"github.com/sirupsen/logrus"
+	"runtime"
 )
 
 // Entry represents a logging entry and all supported method we use
 type Entry struct {
-	logger  *Logger
-	fields  Fields
+	logger *Logger
+	fields Fields
+	// typed are the fields added with With, which take precedence over fields.
+	typed   []Field
 	context context.Context
 }
 
@@ -33,21 +34,110 @@ func (e *Entry) WithFields(fields Fields) *Entry {
 	maps.Copy(newFields, e.fields)
 	// Set new fields
 	maps.Copy(newFields, fields)
-	return &Entry{logger: e.logger, fields: newFields, context: e.context}
+	return &Entry{logger: e.logger, fields: newFields, typed: e.typed, context: e.context}
+}
+
+// With returns a copy of the entry with typed fields added. Typed fields take
+// precedence over fields with the same key added with WithField or WithFields.
+//
+//	logger.WithContext(ctx).With(logger.String("order_id", id), logger.Int64("amount", amount)).Info("payment captured")
+func (e *Entry) With(fields ...Field) *Entry {
+	typed := append(e.typed[:len(e.typed):len(e.typed)], fields...)
+	return &Entry{logger: e.logger, fields: e.fields, typed: typed, context: e.context}
+}
+
+// appendTyped appends fields to typed without changing the backing array of
+// typed, which may be shared with other entries. The result may share the
+// backing array of either argument, so it must not be kept.
+func appendTyped(typed []Field, fields []Field) []Field {
+	if len(fields) == 0 {
+		return typed
+	}
+	if len(typed) == 0 {
+		return fields
+	}
+	return append(typed[:len(typed):len(typed)], fields...)
 }
 
 // WithContext sets the context for the log-message. Useful when using hooks.
+// Fields set on the context with ContextWithFields are added to the entry.
 func (e *Entry) WithContext(ctx context.Context) *Entry {
 	// Make a copy, to prevent mutation of the old entry
-	return &Entry{logger: e.logger, fields: e.fields, context: ctx}
+	return &Entry{logger: e.logger, fields: e.fields, typed: e.typed, context: ctx}
 }
 
-func addCallerFields(logrusFields logrus.Fields, reportCaller bool) {
-	if reportCaller {
-		frame := getCaller()
-		logrusFields["file"] = fmt.Sprintf("%s:%v", frame.File, frame.Line)
-		logrusFields["function"] = frame.Function
+// enabled reports whether entries at level pass the minimum level of the
+// logger, or the level set on the context of the entry with ContextWithLevel.
+// A logger at LevelOff logs nothing, whatever the level of the context.
+func (e *Entry) enabled(level Level) bool {
+	minLevel := e.logger.Level()
+	if minLevel == LevelOff {
+		return false
 	}
+	return minLevel.Enables(level) || enabledByContext(e.context, level)
+}
+
+// callerFrame returns the frame of the first non-go-logger caller, or nil when
+// reporting the caller is disabled.
+func (e *Entry) callerFrame() *runtime.Frame {
+	if !e.logger.reportCaller {
+		return nil
+	}
+	return getCaller(e.logger.callerSkip)
+}
+
+func addCallerFields(fields Fields, frame *runtime.Frame) {
+	if frame != nil {
+		fields[fileKey] = fmt.Sprintf("%s:%v", frame.File, frame.Line)
+		fields[functionKey] = frame.Function
+	}
+}
+
+// write adds the context and caller fields, fires the hooks and hands the
+// entry to the backend of the logger. fields are added to the typed fields of
+// the entry.
+func (e *Entry) write(level Level, frame *runtime.Frame, msg string, fields ...Field) {
+	logger := e.logger
+	r := &record{
+		time:    logger.now(),
+		level:   level,
+		message: msg,
+		fields:  e.fields,
+		typed:   appendTyped(e.typed, fields),
+		base:    logger.fields,
+		frame:   frame,
+		context: e.context,
+	}
+	// Fields set on the entry or the logger take precedence over fields from
+	// the context. The maps are shared with the record, and only merged when
+	// both are set.
+	if ctxFields := contextFields(e.context); len(ctxFields) > 0 {
+		if len(e.fields) == 0 && len(logger.fields) == 0 {
+			r.fields = ctxFields
+		} else {
+			r.fields = make(Fields, len(ctxFields)+len(logger.fields)+len(e.fields))
+			maps.Copy(r.fields, ctxFields)
+			addFields(r.fields, logger.fields)
+			maps.Copy(r.fields, e.fields)
+			r.base = nil
+		}
+	}
+	if frame != nil {
+		// Frame.PC points at the call instruction, while slog expects the
+		// return address as reported by runtime.Callers.
+		r.pc = frame.PC + 1
+	}
+	if logger.service != "" || logger.env != "" || logger.version != "" || logger.traceContext != nil {
+		r.typed = logger.appendServiceFields(r.typed, r.context)
+	}
+	if logger.stacktraceLevel.Enables(level) {
+		r.typed = appendTyped(r.typed, []Field{logger.stacktraceField(frame)})
+	}
+	logger.fireHooks(r)
+	if logger.errorFields {
+		r.expandError()
+	}
+	logger.backend.write(r)
 }
 
 // Info forwards a logging call in the (format, args) format
@@ -80,6 +170,16 @@ func (e *Entry) Debugf(format string, args ...any) {
 	e.Logf(LevelDebug, format, args...)
 }
 
+// Trace forwards a tracing logging call
+func (e *Entry) Trace(args ...any) {
+	e.Log(LevelTrace, args...)
+}
+
+// Tracef forwards a tracing logging call
+func (e *Entry) Tracef(format string, args ...any) {
+	e.Logf(LevelTrace, format, args...)
+}
+
 // Warn forwards a warning logging call
 func (e *Entry) Warn(args ...any) {
 	e.Log(LevelWarn, args...)
@@ -100,26 +200,138 @@ func (e *Entry) Fatalf(format string, args ...any) {
 	e.Logf(LevelFatal, format, args...)
 }
 
+// Panic forwards a panic logging call
+func (e *Entry) Panic(args ...any) {
+	e.Log(LevelPanic, args...)
+}
+
+// Panicf forwards a panic logging call
+func (e *Entry) Panicf(format string, args ...any) {
+	e.Logf(LevelPanic, format, args...)
+}
+
 // Logf forwards a logging call
 func (e *Entry) Logf(level Level, format string, args ...any) {
-	logrusFields := logrus.Fields(e.fields)
-	addCallerFields(logrusFields, e.logger.reportCaller)
-	e.logger.logrusLogger.WithContext(e.context).WithTime(e.logger.now()).WithFields(logrusFields).Logf(mapLevelToLogrusLevel(level), format, args...)
+	if e.enabled(level) {
+		e.write(level, e.callerFrame(), fmt.Sprintf(format, args...))
+	}
 
 	// This ensures that logging with level Fatal results in Exit regardless if using .Fatalf or .Logf(LevelFatal, ...)
 	if level == LevelFatal {
-		e.logger.logrusLogger.Exit(1)
+		e.logger.exit(1)
+	}
+	// Likewise, logging with level Panic always panics.
+	if level == LevelPanic {
+		panic(fmt.Sprintf(format, args...))
 	}
 }
 
 // Log forwards a logging call
 func (e *Entry) Log(level Level, args ...any) {
-	logrusFields := logrus.Fields(e.fields)
-	addCallerFields(logrusFields, e.logger.reportCaller)
-	e.logger.logrusLogger.WithContext(e.context).WithTime(e.logger.now()).WithFields(logrusFields).Log(mapLevelToLogrusLevel(level), args...)
+	if e.enabled(level) {
+		e.write(level, e.callerFrame(), fmt.Sprint(args...))
+	}
 
 	// This ensures that logging with level Fatal results in Exit regardless if using .Fatal or .Log(LevelFatal, ...)
 	if level == LevelFatal {
-		e.logger.logrusLogger.Exit(1)
+		e.logger.exit(1)
+	}
+	// Likewise, logging with level Panic always panics.
+	if level == LevelPanic {
+		panic(fmt.Sprint(args...))
+	}
+}
+
+// Infow forwards an info logging call with alternating keys and values
+func (e *Entry) Infow(msg string, keysAndValues ...any) {
+	e.Logw(LevelInfo, msg, keysAndValues...)
+}
+
+// Errorw forwards an error logging call with alternating keys and values
+func (e *Entry) Errorw(msg string, keysAndValues ...any) {
+	e.Logw(LevelError, msg, keysAndValues...)
+}
+
+// Debugw forwards a debugging logging call with alternating keys and values
+func (e *Entry) Debugw(msg string, keysAndValues ...any) {
+	e.Logw(LevelDebug, msg, keysAndValues...)
+}
+
+// Tracew forwards a tracing logging call with alternating keys and values
+func (e *Entry) Tracew(msg string, keysAndValues ...any) {
+	e.Logw(LevelTrace, msg, keysAndValues...)
+}
+
+// Warnw forwards a warning logging call with alternating keys and values
+func (e *Entry) Warnw(msg string, keysAndValues ...any) {
+	e.Logw(LevelWarn, msg, keysAndValues...)
+}
+
+// Fatalw forwards a fatal logging call with alternating keys and values
+func (e *Entry) Fatalw(msg string, keysAndValues ...any) {
+	e.Logw(LevelFatal, msg, keysAndValues...)
+}
+
+// Panicw forwards a panic logging call with alternating keys and values
+func (e *Entry) Panicw(msg string, keysAndValues ...any) {
+	e.Logw(LevelPanic, msg, keysAndValues...)
+}
+
+// LogFields forwards a logging call with typed fields. When the logger has no
+// hooks, the fields are written without building a map.
+//
+//	logger.LogFields(logger.LevelInfo, "payment captured", logger.String("order_id", id))
+func (e *Entry) LogFields(level Level, msg string, fields ...Field) {
+	if e.enabled(level) {
+		e.write(level, e.callerFrame(), msg, fields...)
+	}
+
+	// Same as Log, logging with level Fatal exits and logging with level Panic panics.
+	if level == LevelFatal {
+		e.logger.exit(1)
+	}
+	if level == LevelPanic {
+		panic(msg)
+	}
+}
+
+// Logw forwards a logging call with alternating keys and values, which are
+// added as fields:
+//
+//	logger.Infow("payment captured", "order_id", id, "amount", amt)
+//
+// A key that is not a string, or a key without a value, is added as the value
+// of the field "!BADKEY" instead.
+func (e *Entry) Logw(level Level, msg string, keysAndValues ...any) {
+	if e.enabled(level) {
+		e.WithFields(keysAndValuesToFields(keysAndValues)).write(level, e.callerFrame(), msg)
+	}
+
+	// Same as Log, logging with level Fatal exits and logging with level Panic panics.
+	if level == LevelFatal {
+		e.logger.exit(1)
+	}
+	if level == LevelPanic {
+		panic(msg)
+	}
+}
+
+// badKey is the key of values passed to Logw without a valid key.
+const badKey = "!BADKEY"
+
+// keysAndValuesToFields converts alternating keys and values to Fields. When
+// there are several values without a valid key, the last one is kept.
+func keysAndValuesToFields(keysAndValues []any) Fields {
+	fields := make(Fields, (len(keysAndValues)+1)/2)
+	for i := 0; i < len(keysAndValues); {
+		key, ok := keysAndValues[i].(string)
+		if !ok || i+1 == len(keysAndValues) {
+			fields[badKey] = keysAndValues[i]
+			i++
+			continue
+		}
+		fields[key] = keysAndValues[i+1]
+		i += 2
 	}
+	return fields
 }
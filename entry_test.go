@@ -3,6 +3,7 @@ package logger
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -93,3 +94,39 @@ func TestNoContextMutation(t *testing.T) {
 		t.Fatalf("The second WithContext did not set context in entry3.")
 	}
 }
+
+func TestKeysAndValuesToFields(t *testing.T) {
+	testCases := map[string]struct {
+		keysAndValues []any
+		expected      Fields
+	}{
+		"empty": {
+			keysAndValues: nil,
+			expected:      Fields{},
+		},
+		"pairs": {
+			keysAndValues: []any{"order_id", 42, "amount", 9.5},
+			expected:      Fields{"order_id": 42, "amount": 9.5},
+		},
+		"missing value": {
+			keysAndValues: []any{"order_id", 42, "amount"},
+			expected:      Fields{"order_id": 42, badKey: "amount"},
+		},
+		"non-string key": {
+			keysAndValues: []any{42, "order_id", 43},
+			expected:      Fields{badKey: 42, "order_id": 43},
+		},
+		"last bad value is kept": {
+			keysAndValues: []any{1, 2},
+			expected:      Fields{badKey: 2},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := keysAndValuesToFields(tc.keysAndValues)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
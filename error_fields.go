@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// Keys of the fields the error is expanded into with WithErrorFields.
+const (
+	errorMessageKey = "message"
+	errorKindKey    = "kind"
+	errorChainKey   = "chain"
+	errorStackKey   = "stack"
+)
+
+// expandError replaces the error field of the record with an object holding
+// the message, kind, chain and stack of the error, see WithErrorFields.
+func (r *record) expandError() {
+	err, ok := r.errorValue()
+	if !ok || err == nil {
+		return
+	}
+
+	fields := []Field{
+		String(errorMessageKey, err.Error()),
+		String(errorKindKey, errorType(err)),
+	}
+	var chain []Fields
+	var stack string
+	root := true
+	walkErrors(err, func(e error) {
+		if !root {
+			chain = append(chain, Fields{errorMessageKey: e.Error(), errorKindKey: errorType(e)})
+		}
+		root = false
+		if s := errorStack(e); s != "" {
+			stack = s
+		}
+	})
+	if len(chain) > 0 {
+		fields = append(fields, Any(errorChainKey, chain))
+	}
+	if stack != "" {
+		fields = append(fields, String(errorStackKey, stack))
+	}
+	r.typed = appendTyped(r.typed, []Field{Object(errorKey, fields...)})
+}
+
+// errorValue returns the value of the error field of the record, if it is an
+// error. Typed fields take precedence, followed by fields and the fields of
+// the logger, like when the record is written.
+func (r *record) errorValue() (error, bool) {
+	for i := len(r.typed) - 1; i >= 0; i-- {
+		if r.typed[i].key == errorKey && r.typed[i].kind != skipKind {
+			err, ok := r.typed[i].value.(error)
+			return err, ok
+		}
+	}
+	if value, ok := r.fields[errorKey]; ok {
+		err, ok := value.(error)
+		return err, ok
+	}
+	for i := len(r.base) - 1; i >= 0; i-- {
+		if r.base[i].key == errorKey && r.base[i].kind != skipKind {
+			err, ok := r.base[i].value.(error)
+			return err, ok
+		}
+	}
+	return nil, false
+}
+
+// errorType returns the Go type of err, e.g. *fs.PathError.
+func errorType(err error) string {
+	return fmt.Sprintf("%T", err)
+}
+
+// walkErrors calls fn for err and all errors it wraps, depth first, following
+// both Unwrap() error and Unwrap() []error as returned by errors.Join.
+func walkErrors(err error, fn func(error)) {
+	fn(err)
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if next := u.Unwrap(); next != nil {
+			walkErrors(next, fn)
+		}
+	case interface{ Unwrap() []error }:
+		for _, next := range u.Unwrap() {
+			if next != nil {
+				walkErrors(next, fn)
+			}
+		}
+	}
+}
+
+// errorStack returns the stack trace carried by err, or an empty string. Both
+// a Stack() []byte method and a StackTrace() method returning program
+// counters, like the one of github.com/pkg/errors, are supported.
+func errorStack(err error) string {
+	if s, ok := err.(interface{ Stack() []byte }); ok {
+		return string(s.Stack())
+	}
+	method := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !method.IsValid() {
+		return ""
+	}
+	t := method.Type()
+	if t.NumIn() != 0 || t.NumOut() != 1 || t.Out(0).Kind() != reflect.Slice || t.Out(0).Elem().Kind() != reflect.Uintptr {
+		return ""
+	}
+	trace := method.Call(nil)[0]
+	pcs := make([]uintptr, trace.Len())
+	for i := range pcs {
+		pcs[i] = uintptr(trace.Index(i).Uint())
+	}
+	return formatStack(pcs)
+}
+
+// formatStack formats the program counters returned by runtime.Callers like
+// the stack traces of a panic, with the function and file:line of every frame.
+func formatStack(pcs []uintptr) string {
+	var frames []runtime.Frame
+	callers := runtime.CallersFrames(pcs)
+	for {
+		frame, more := callers.Next()
+		frames = append(frames, frame)
+		if !more {
+			break
+		}
+	}
+	return formatFrames(frames)
+}
@@ -0,0 +1,153 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stackFrame and stackTrace mimic the types of github.com/pkg/errors.
+type stackFrame uintptr
+
+type stackTrace []stackFrame
+
+type stackError struct {
+	msg   string
+	stack []uintptr
+}
+
+func newStackError(msg string) *stackError {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(1, pcs)
+	return &stackError{msg: msg, stack: pcs[:n]}
+}
+
+func (e *stackError) Error() string {
+	return e.msg
+}
+
+func (e *stackError) StackTrace() stackTrace {
+	trace := make(stackTrace, len(e.stack))
+	for i, pc := range e.stack {
+		trace[i] = stackFrame(pc)
+	}
+	return trace
+}
+
+type byteStackError struct{}
+
+func (byteStackError) Error() string { return "byte stack" }
+
+func (byteStackError) Stack() []byte { return []byte("goroutine 1 [running]:\n") }
+
+func TestErrorFields(t *testing.T) {
+	wrapped := fmt.Errorf("open config: %w", &fs.PathError{Op: "open", Path: "/etc/app", Err: fs.ErrNotExist})
+	joined := errors.Join(errors.New("first"), errors.New("second"))
+
+	testCases := map[string]struct {
+		log      func(logger *Logger)
+		expected map[string]any
+	}{
+		"WithError": {
+			log: func(logger *Logger) { logger.WithError(errors.New("some error")).Error("foobar") },
+			expected: map[string]any{
+				"message": "some error",
+				"kind":    "*errors.errorString",
+			},
+		},
+		"Err with chain": {
+			log: func(logger *Logger) { logger.LogFields(LevelError, "foobar", Err(wrapped)) },
+			expected: map[string]any{
+				"message": "open config: open /etc/app: file does not exist",
+				"kind":    "*fmt.wrapError",
+				"chain": []any{
+					map[string]any{"message": "open /etc/app: file does not exist", "kind": "*fs.PathError"},
+					map[string]any{"message": "file does not exist", "kind": "*errors.errorString"},
+				},
+			},
+		},
+		"joined errors": {
+			log: func(logger *Logger) { logger.WithError(joined).Error("foobar") },
+			expected: map[string]any{
+				"message": "first\nsecond",
+				"kind":    "*errors.joinError",
+				"chain": []any{
+					map[string]any{"message": "first", "kind": "*errors.errorString"},
+					map[string]any{"message": "second", "kind": "*errors.errorString"},
+				},
+			},
+		},
+		"Stack method": {
+			log: func(logger *Logger) { logger.WithError(byteStackError{}).Error("foobar") },
+			expected: map[string]any{
+				"message": "byte stack",
+				"kind":    "logger.byteStackError",
+				"stack":   "goroutine 1 [running]:\n",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			tc.log(New(WithOutput(buf), WithErrorFields(true)))
+			assert.Equal(t, tc.expected, decodeLogToMap(t, buf)["error"])
+		})
+	}
+}
+
+func TestErrorFieldsStackTrace(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithErrorFields(true))
+
+	logger.WithError(fmt.Errorf("wrapped: %w", newStackError("with stack"))).Error("foobar")
+
+	errorFields, ok := decodeLogToMap(t, buf)["error"].(map[string]any)
+	require.True(t, ok)
+	assert.Regexp(t, `^github\.com/coopnorge/go-logger\.newStackError\n\t.*error_fields_test\.go:\d+\n`, errorFields["stack"])
+	assert.Contains(t, errorFields["stack"], "TestErrorFieldsStackTrace")
+}
+
+func TestErrorFieldsDisabled(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf))
+
+	logger.WithError(errors.New("some error")).Error("foobar")
+	assertLogEntryContains(t, buf, "error", "some error")
+}
+
+func TestErrorFieldsIgnoreNonErrors(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithErrorFields(true))
+
+	logger.WithError(errors.New("overridden")).With(String("error", "not an error")).Error("foobar")
+	assertLogEntryContains(t, buf, "error", "not an error")
+}
+
+func TestErrorFieldsHooksGetError(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := errors.New("some error")
+	var hookErr any
+	logger := New(WithOutput(buf), WithErrorFields(true), WithHookFunc(func(e *HookEntry) (bool, error) {
+		hookErr = e.Data["error"]
+		return false, nil
+	}))
+
+	logger.WithError(err).Error("foobar")
+	assert.Equal(t, err, hookErr)
+	assert.Equal(t, map[string]any{"message": "some error", "kind": "*errors.errorString"}, decodeLogToMap(t, buf)["error"])
+}
+
+func TestErrorFieldsWithSlogHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithSlogHandler(slog.NewJSONHandler(buf, nil)), WithErrorFields(true))
+
+	logger.WithError(errors.New("some error")).Error("foobar")
+	assert.Equal(t, map[string]any{"message": "some error", "kind": "*errors.errorString"}, decodeLogToMap(t, buf)["error"])
+}
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+var (
+	exitHandlersMu sync.Mutex
+	exitHandlers   []func()
+)
+
+// RegisterExitHandler registers a function that is called when an entry is
+// logged at LevelFatal, before the process exits. Use it to flush buffered
+// sinks or drain servers. Handlers are called in the order they were
+// registered, for entries logged with any logger. A panic in a handler is
+// printed to stderr and does not stop the other handlers from running.
+func RegisterExitHandler(handler func()) {
+	if handler == nil {
+		return
+	}
+	exitHandlersMu.Lock()
+	defer exitHandlersMu.Unlock()
+	exitHandlers = append(exitHandlers, handler)
+}
+
+func runExitHandlers() {
+	exitHandlersMu.Lock()
+	handlers := exitHandlers[:len(exitHandlers):len(exitHandlers)]
+	exitHandlersMu.Unlock()
+
+	for _, handler := range handlers {
+		runExitHandler(handler)
+	}
+}
+
+func runExitHandler(handler func()) {
+	defer func() {
+		if err := recover(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to run exit handler: %v\n", err)
+		}
+	}()
+	handler()
+}
+
+// exit runs the exit handlers and then calls the exit func of the logger.
+func (logger *Logger) exit(code int) {
+	runExitHandlers()
+	logger.exitFunc(code)
+}
+
+// ExitError is the value PanicOnExit panics with.
+type ExitError struct {
+	Code int
+}
+
+func (e ExitError) Error() string {
+	return fmt.Sprintf("exit with code %d", e.Code)
+}
+
+// PanicOnExit is an exit func for WithExitFunc that panics with an ExitError
+// instead of exiting. It is intended to be used in tests, to assert that a
+// fatal entry was logged without the process dying:
+//
+//	logger := logger.New(logger.WithOutput(buf), logger.WithExitFunc(logger.PanicOnExit))
+//	assert.PanicsWithValue(t, logger.ExitError{Code: 1}, func() { run(logger) })
+func PanicOnExit(code int) {
+	panic(ExitError{Code: code})
+}
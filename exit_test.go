@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func resetExitHandlers(t *testing.T) {
+	exitHandlersMu.Lock()
+	old := exitHandlers
+	exitHandlers = nil
+	exitHandlersMu.Unlock()
+	t.Cleanup(func() {
+		exitHandlersMu.Lock()
+		exitHandlers = old
+		exitHandlersMu.Unlock()
+	})
+}
+
+func TestWithExitFunc(t *testing.T) {
+	buf := &bytes.Buffer{}
+	codes := []int{}
+	logger := New(WithOutput(buf), WithExitFunc(func(code int) {
+		codes = append(codes, code)
+	}))
+
+	logger.Fatal("foobar")
+	logger.WithField("foo", "bar").Fatalf("foo%s", "bar")
+	assert.Equal(t, []int{1, 1}, codes)
+}
+
+func TestWithExitFuncNil(t *testing.T) {
+	logger := New(WithExitFunc(nil))
+	assert.Equal(t, reflect.ValueOf(os.Exit).Pointer(), reflect.ValueOf(logger.exitFunc).Pointer())
+}
+
+func TestRegisterExitHandler(t *testing.T) {
+	resetExitHandlers(t)
+
+	calls := []string{}
+	RegisterExitHandler(func() { calls = append(calls, "first") })
+	RegisterExitHandler(func() { panic("broken handler") })
+	RegisterExitHandler(nil)
+	RegisterExitHandler(func() { calls = append(calls, "second") })
+
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithExitFunc(func(int) {
+		calls = append(calls, "exit")
+	}))
+	logger.Fatal("foobar")
+
+	assert.Equal(t, []string{"first", "second", "exit"}, calls)
+	assertLogEntryContains(t, buf, "level", "fatal")
+}
+
+func TestExitHandlersRunForDisabledFatal(t *testing.T) {
+	resetExitHandlers(t)
+
+	calls := 0
+	RegisterExitHandler(func() { calls++ })
+
+	logger := New(WithLevel(LevelOff), WithExitFunc(func(int) {}))
+	logger.Fatal("foobar")
+	assert.Equal(t, 1, calls)
+}
+
+func TestPanicOnExit(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithExitFunc(PanicOnExit))
+
+	assert.PanicsWithValue(t, ExitError{Code: 1}, func() {
+		logger.Fatal("foobar")
+		t.Fatal("Fatal must not return")
+	})
+	assertLogEntryContains(t, buf, "msg", "foobar")
+	assert.EqualError(t, ExitError{Code: 1}, "exit with code 1")
+}
@@ -0,0 +1,219 @@
+package logger
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Field is a typed key/value pair added to an entry with Logger.With,
+// Entry.With or LogFields. Unlike Fields, typed fields of common types are
+// stored without boxing their value, and are written without building a map
+// when the logger has no hooks. Create fields with the constructors such as
+// String, Int64 or Err.
+type Field struct {
+	key     string
+	kind    fieldKind
+	integer int64
+	str     string
+	value   any
+}
+
+type fieldKind uint8
+
+const (
+	anyKind fieldKind = iota
+	skipKind
+	stringKind
+	int64Kind
+	uint64Kind
+	float64Kind
+	boolKind
+	durationKind
+	timeKind
+	errorKind
+	objectKind
+	// callerFileKind is the "file" field written for the caller, with the
+	// file in str and the line in integer.
+	callerFileKind
+	// builtinKind is a string field added by go-logger itself, like the
+	// service and trace fields, with the value in str. Only fields of this
+	// kind are renamed to the keys set with WithKeys, so fields of the user
+	// with the same key keep their key.
+	builtinKind
+)
+
+// String returns a field with a string value.
+func String(key, value string) Field {
+	return Field{key: key, kind: stringKind, str: value}
+}
+
+// Int returns a field with an int value.
+func Int(key string, value int) Field {
+	return Int64(key, int64(value))
+}
+
+// Int64 returns a field with an int64 value.
+func Int64(key string, value int64) Field {
+	return Field{key: key, kind: int64Kind, integer: value}
+}
+
+// Uint64 returns a field with an uint64 value.
+func Uint64(key string, value uint64) Field {
+	return Field{key: key, kind: uint64Kind, integer: int64(value)}
+}
+
+// Float64 returns a field with a float64 value.
+func Float64(key string, value float64) Field {
+	return Field{key: key, kind: float64Kind, integer: int64(math.Float64bits(value))}
+}
+
+// Bool returns a field with a bool value.
+func Bool(key string, value bool) Field {
+	var integer int64
+	if value {
+		integer = 1
+	}
+	return Field{key: key, kind: boolKind, integer: integer}
+}
+
+// Duration returns a field with a time.Duration value, written as a number of
+// nanoseconds like in Fields.
+func Duration(key string, value time.Duration) Field {
+	return Field{key: key, kind: durationKind, integer: int64(value)}
+}
+
+// minTimeNanos and maxTimeNanos bound the times that can be stored as Unix
+// nanoseconds.
+var (
+	minTimeNanos = time.Unix(0, math.MinInt64)
+	maxTimeNanos = time.Unix(0, math.MaxInt64)
+)
+
+// Time returns a field with a time.Time value, written in the RFC 3339 format
+// with nanoseconds like in Fields.
+func Time(key string, value time.Time) Field {
+	if value.Before(minTimeNanos) || value.After(maxTimeNanos) {
+		return Any(key, value)
+	}
+	return Field{key: key, kind: timeKind, integer: value.UnixNano(), value: value.Location()}
+}
+
+// Err returns a field with err as the "error" field, like WithError. The
+// field is omitted when err is nil.
+func Err(err error) Field {
+	if err == nil {
+		return Field{kind: skipKind}
+	}
+	return Field{key: errorKey, kind: errorKind, value: err}
+}
+
+// Any returns a field with a value of any type, written the same way as the
+// value would be in Fields.
+func Any(key string, value any) Field {
+	return Field{key: key, kind: anyKind, value: value}
+}
+
+// Object returns a field that nests fields under key, written as a JSON
+// object.
+//
+//	logger.Object("user", logger.String("name", name), logger.Int64("id", id))
+func Object(key string, fields ...Field) Field {
+	return Field{key: key, kind: objectKind, value: fields}
+}
+
+// callerFields returns the "file" and "function" fields for frame, see
+// addCallerFields.
+func callerFields(frame *runtime.Frame) [2]Field {
+	return [2]Field{
+		{key: fileKey, kind: callerFileKind, str: frame.File, integer: int64(frame.Line)},
+		String(functionKey, frame.Function),
+	}
+}
+
+// Value returns the value of the field, as it would be stored in Fields.
+func (f Field) Value() any {
+	switch f.kind {
+	case skipKind:
+		return nil
+	case stringKind, builtinKind:
+		return f.str
+	case int64Kind:
+		return f.integer
+	case uint64Kind:
+		return uint64(f.integer)
+	case float64Kind:
+		return math.Float64frombits(uint64(f.integer))
+	case boolKind:
+		return f.integer == 1
+	case durationKind:
+		return time.Duration(f.integer)
+	case timeKind:
+		return time.Unix(0, f.integer).In(f.value.(*time.Location))
+	case objectKind:
+		fields := Fields{}
+		addFields(fields, f.value.([]Field))
+		return fields
+	case callerFileKind:
+		return fmt.Sprintf("%s:%v", f.str, f.integer)
+	}
+	return f.value
+}
+
+// Key returns the key of the field.
+func (f Field) Key() string {
+	return f.key
+}
+
+// addFields adds typed fields to fields, later fields overwriting earlier
+// fields with the same key.
+func addFields(fields Fields, typed []Field) {
+	for _, f := range typed {
+		if f.kind != skipKind {
+			fields[f.key] = f.Value()
+		}
+	}
+}
+
+// sortFields sorts fields by key and removes skipped fields and all but the
+// last of the fields with the same key. fields is sorted in place.
+func sortFields(fields []Field) []Field {
+	slices.SortStableFunc(fields, func(a, b Field) int {
+		return strings.Compare(a.key, b.key)
+	})
+	n := 0
+	for i, f := range fields {
+		if f.kind == skipKind || i+1 < len(fields) && fields[i+1].key == f.key {
+			continue
+		}
+		fields[n] = f
+		n++
+	}
+	clear(fields[n:])
+	return fields[:n]
+}
+
+// nestedFields returns the members of an object field or of a field holding
+// Fields, sorted like sortFields, for encoders that flatten nested fields to
+// dotted keys.
+func nestedFields(f Field) ([]Field, bool) {
+	switch f.kind {
+	case objectKind:
+		return sortFields(slices.Clone(f.value.([]Field))), true
+	case anyKind:
+		nested, ok := resolveValue(f.value).(Fields)
+		if !ok {
+			return nil, false
+		}
+		members := make([]Field, 0, len(nested))
+		for _, key := range slices.Sorted(maps.Keys(nested)) {
+			members = append(members, Any(key, nested[key]))
+		}
+		return members, true
+	}
+	return nil, false
+}
@@ -0,0 +1,169 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldValue(t *testing.T) {
+	now := time.Date(2020, 2, 3, 4, 5, 6, 7, time.FixedZone("CET", 3600))
+	err := errors.New("some error")
+	testCases := map[string]struct {
+		field    Field
+		expected any
+	}{
+		"String":         {field: String("key", "value"), expected: "value"},
+		"Int":            {field: Int("key", -42), expected: int64(-42)},
+		"Int64":          {field: Int64("key", math.MinInt64), expected: int64(math.MinInt64)},
+		"Uint64":         {field: Uint64("key", math.MaxUint64), expected: uint64(math.MaxUint64)},
+		"Float64":        {field: Float64("key", 1.5), expected: 1.5},
+		"Bool true":      {field: Bool("key", true), expected: true},
+		"Bool false":     {field: Bool("key", false), expected: false},
+		"Duration":       {field: Duration("key", time.Second), expected: time.Second},
+		"Time":           {field: Time("key", now), expected: now},
+		"Time far ahead": {field: Time("key", now.AddDate(1000, 0, 0)), expected: now.AddDate(1000, 0, 0)},
+		"Err":            {field: Err(err), expected: err},
+		"Err nil":        {field: Err(nil), expected: nil},
+		"Any":            {field: Any("key", []int{1}), expected: []int{1}},
+		"Object": {
+			field:    Object("key", String("a", "b"), Object("nested", Bool("c", true))),
+			expected: Fields{"a": "b", "nested": Fields{"c": true}},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.field.Value())
+		})
+	}
+}
+
+func TestFieldJSONMatchesFields(t *testing.T) {
+	now := time.Date(2020, 2, 3, 4, 5, 6, 7, time.FixedZone("CET", 3600))
+	testCases := map[string]Field{
+		"String":       String("key", "<value>\n"),
+		"Int64":        Int64("key", math.MinInt64),
+		"Uint64":       Uint64("key", math.MaxUint64),
+		"Float64":      Float64("key", 1e21),
+		"Float64 NaN":  Float64("key", math.NaN()),
+		"Bool":         Bool("key", true),
+		"Duration":     Duration("key", 1500*time.Millisecond),
+		"Time":         Time("key", now),
+		"Err":          Err(errors.New("some error")),
+		"Any":          Any("key", map[string]int{"b": 1, "a": 2}),
+		"Object":       Object("key", String("b", "1"), Int("a", 2), Int("a", 3)),
+		"Empty object": Object("key"),
+		"Clashing key": String("msg", "value"),
+	}
+	for name, field := range testCases {
+		t.Run(name, func(t *testing.T) {
+			typed := &record{time: now, level: LevelInfo, message: "hello", typed: []Field{field}}
+			fields := &record{time: now, level: LevelInfo, message: "hello", fields: Fields{field.Key(): field.Value()}}
+			assert.Equal(t, string(jsonEncoder{}.encode(nil, fields)), string(jsonEncoder{}.encode(nil, typed)))
+		})
+	}
+}
+
+func TestLogFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo), WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	logger.LogFields(LevelInfo, "payment captured", String("order_id", "abc"), Int64("amount", 42), Err(nil))
+	assert.Equal(t, `{"amount":42,"level":"info","msg":"payment captured","order_id":"abc","time":"2020-10-10T10:10:10.001Z"}`+"\n", buf.String())
+
+	buf.Reset()
+	logger.LogFields(LevelDebug, "filtered", String("order_id", "abc"))
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestFieldPrecedence(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo))
+
+	ctx := ContextWithFields(t.Context(), Fields{"a": "context", "b": "context", "c": "context", "file": "context"})
+	logger.With(String("a", "logger"), String("b", "logger")).
+		WithContext(ctx).
+		With(String("a", "with"), String("file", "with")).
+		WithField("b", "field").
+		LogFields(LevelInfo, "foobar", String("a", "log"))
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, "log", log["a"])
+	assert.Equal(t, "field", log["b"])
+	assert.Equal(t, "context", log["c"])
+	assert.Regexp(t, `field_test\.go:\d+$`, log["file"])
+}
+
+func TestEntryWithDoesNotMutate(t *testing.T) {
+	builder := &strings.Builder{}
+	logger := New(WithOutput(builder), WithLevel(LevelInfo), WithReportCaller(false))
+
+	fields := []Field{String("a", "1")}
+	parent := logger.WithContext(t.Context()).With(fields...)
+	fields[0] = String("a", "mutated")
+	child1 := parent.With(String("b", "1"))
+	child2 := parent.With(String("b", "2"))
+
+	parent.Info("parent")
+	child1.Info("child1")
+	child2.Info("child2")
+
+	lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.NotContains(t, decodeLogToMap(t, strings.NewReader(lines[0])), "b")
+	assert.Equal(t, "1", decodeLogToMap(t, strings.NewReader(lines[0]))["a"])
+	assert.Equal(t, "1", decodeLogToMap(t, strings.NewReader(lines[1]))["b"])
+	assert.Equal(t, "2", decodeLogToMap(t, strings.NewReader(lines[2]))["b"])
+}
+
+func TestFieldsInHooks(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var data Fields
+	logger := New(WithOutput(buf), WithLevel(LevelInfo), WithHookFunc(func(e *HookEntry) (bool, error) {
+		data = e.Data
+		e.Data["amount"] = e.Data["amount"].(int64) + 1
+		return true, nil
+	}))
+
+	logger.LogFields(LevelInfo, "foobar", Int64("amount", 42), Object("user", String("name", "peter")))
+
+	assert.Equal(t, Fields{"name": "peter"}, data["user"])
+	assert.Contains(t, data, "file")
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, float64(43), log["amount"])
+	assert.Equal(t, map[string]any{"name": "peter"}, log["user"])
+}
+
+func TestFieldsWithSlogHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithSlogHandler(slog.NewJSONHandler(buf, nil)), WithLevel(LevelInfo), WithReportCaller(false))
+
+	logger.LogFields(LevelInfo, "foobar", Int64("amount", 42), Object("user", String("name", "peter")))
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, float64(42), log["amount"])
+	assert.Equal(t, map[string]any{"name": "peter"}, log["user"])
+}
+
+func TestLogFieldsDoesNotAllocateMaps(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector adds allocations")
+	}
+	logger := New(WithOutput(io.Discard), WithLevel(LevelInfo), WithReportCaller(false))
+	entry := logger.With(String("component", "payments")).WithContext(t.Context())
+
+	allocs := testing.AllocsPerRun(100, func() {
+		entry.LogFields(LevelInfo, "foobar", String("order_id", "abc"), Int64("amount", 42), Duration("elapsed", time.Second))
+	})
+	// The record, the variadic fields and the timestamp.
+	assert.LessOrEqual(t, allocs, float64(3))
+}
@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// Format is the layout entries are written to the output in.
+type Format uint8
+
+const (
+	// FormatJSON writes every entry as a single line JSON document, the
+	// default when the output is not a terminal.
+	FormatJSON Format = iota
+	// FormatConsole writes entries as aligned, human-readable text for local
+	// development, the default when the output is a terminal. Colours are
+	// used when the output is a terminal and NO_COLOR is not set.
+	FormatConsole
+	// FormatLogfmt writes every entry as a single logfmt line, with the same
+	// fields as FormatJSON and nested fields flattened to dotted keys.
+	FormatLogfmt
+)
+
+// encoder returns the encoder for the format, writing entries with enc and
+// colourised when color is set and the format supports it.
+func (f Format) encoder(enc *encoding, color bool) encoder {
+	switch f {
+	case FormatConsole:
+		return consoleEncoder{enc: enc, color: color}
+	case FormatLogfmt:
+		return logfmtEncoder{enc: enc}
+	}
+	return jsonEncoder{enc: enc}
+}
+
+// encoderConfig is the configuration an encoder is built from.
+type encoderConfig struct {
+	format         Format
+	color          bool
+	keys           Keys
+	timeFormat     string
+	levelNames     *levelNames
+	sourceLocation string
+}
+
+// updateEncoder sets the encoder for the format, keys and time format of the
+// logger. The format is FormatConsole when no format is set with WithFormat
+// and the output is a terminal. Loggers writing through a slog.Handler are
+// left alone. The backend is only replaced when the configuration changed,
+// so options like WithLevel can be applied while the logger is in use.
+func (logger *Logger) updateEncoder() {
+	b, ok := logger.backend.(*encoderBackend)
+	if !ok {
+		return
+	}
+	terminal := isTerminal(logger.output)
+	format := logger.format
+	if !logger.formatSet {
+		format = FormatJSON
+		if terminal {
+			format = FormatConsole
+		}
+	}
+	_, noColor := os.LookupEnv("NO_COLOR")
+	config := encoderConfig{
+		format:         format,
+		color:          terminal && !noColor,
+		keys:           logger.keys,
+		timeFormat:     logger.timeFormat,
+		levelNames:     logger.levelNames,
+		sourceLocation: logger.sourceLocation,
+	}
+	if b.encoder != nil && b.config == config {
+		return
+	}
+	enc := newEncoding(config.keys, config.timeFormat)
+	enc.levelNames, enc.sourceLocation = config.levelNames, config.sourceLocation
+	logger.backend = &encoderBackend{core: b.core, encoder: format.encoder(enc, config.color), config: config}
+}
+
+// isTerminal reports whether w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
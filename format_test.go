@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFormat(t *testing.T) {
+	testCases := map[string]struct {
+		opts     []LoggerOption
+		expected string
+	}{
+		"default": {
+			expected: `{"level":"warning","msg":"foobar","time":"2020-10-10T10:10:10.001Z"}` + "\n",
+		},
+		"JSON": {
+			opts:     []LoggerOption{WithFormat(FormatJSON)},
+			expected: `{"level":"warning","msg":"foobar","time":"2020-10-10T10:10:10.001Z"}` + "\n",
+		},
+		"console": {
+			opts:     []LoggerOption{WithFormat(FormatConsole)},
+			expected: "WARN  10:10:10.001 foobar\n",
+		},
+		"logfmt": {
+			opts:     []LoggerOption{WithFormat(FormatLogfmt)},
+			expected: "time=2020-10-10T10:10:10.001Z level=warning msg=foobar\n",
+		},
+		"last format wins": {
+			opts:     []LoggerOption{WithFormat(FormatConsole), WithFormat(FormatJSON)},
+			expected: `{"level":"warning","msg":"foobar","time":"2020-10-10T10:10:10.001Z"}` + "\n",
+		},
+		"format replaces slog handler": {
+			opts:     []LoggerOption{WithSlogHandler(slog.DiscardHandler), WithFormat(FormatConsole)},
+			expected: "WARN  10:10:10.001 foobar\n",
+		},
+		"slog handler replaces format": {
+			opts: []LoggerOption{WithFormat(FormatConsole), WithSlogHandler(slog.DiscardHandler)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			opts := append([]LoggerOption{WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false)}, tc.opts...)
+			logger := New(opts...)
+
+			logger.Warn("foobar")
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestWithFormatGlobalLogger(t *testing.T) {
+	oldOutput, oldNowFunc, oldReportCaller := globalLogger.output, globalLogger.now, globalLogger.reportCaller
+	defer func() {
+		globalLogger.format, globalLogger.formatSet = FormatJSON, false
+		ConfigureGlobalLogger(WithOutput(oldOutput), WithNowFunc(oldNowFunc), WithReportCaller(oldReportCaller))
+	}()
+
+	buf := &bytes.Buffer{}
+	ConfigureGlobalLogger(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithFormat(FormatConsole))
+	Warn("foobar")
+	assert.Equal(t, "WARN  10:10:10.001 foobar\n", buf.String())
+}
+
+func TestApplyOptionsWhileLogging(t *testing.T) {
+	logger := New(WithOutput(io.Discard), WithFormat(FormatLogfmt))
+
+	wg := sync.WaitGroup{}
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				logger.Warn("foobar")
+			}
+		})
+	}
+	for _, level := range []Level{LevelDebug, LevelInfo, LevelError, LevelWarn} {
+		logger.applyOptions(WithLevel(level))
+	}
+	wg.Wait()
+}
+
+func Test_isTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	assert.NoError(t, err)
+	defer r.Close()
+	defer w.Close()
+
+	assert.False(t, isTerminal(w))
+	assert.False(t, isTerminal(&bytes.Buffer{}))
+}
@@ -3,6 +3,7 @@ package logger
 import (
 	"context"
 	"io"
+	"time"
 )
 
 var globalLogger = New()
@@ -67,6 +68,16 @@ func Debugf(format string, args ...any) {
 	globalLogger.Debugf(format, args...)
 }
 
+// Trace uses global logger to log payload on "trace" level
+func Trace(args ...any) {
+	globalLogger.Trace(args...)
+}
+
+// Tracef uses global logger to log payload on "trace" level
+func Tracef(format string, args ...any) {
+	globalLogger.Tracef(format, args...)
+}
+
 // Warn uses global logger to log payload on "warn" level
 func Warn(args ...any) {
 	globalLogger.Warn(args...)
@@ -87,6 +98,21 @@ func Fatalf(format string, args ...any) {
 	globalLogger.Fatalf(format, args...)
 }
 
+// Panic uses global logger to log payload on "panic" level, and then panics
+func Panic(args ...any) {
+	globalLogger.Panic(args...)
+}
+
+// Panicf uses global logger to log payload on "panic" level, and then panics
+func Panicf(format string, args ...any) {
+	globalLogger.Panicf(format, args...)
+}
+
+// LogFields uses global logger to log a message with typed fields on the level of the first parameter
+func LogFields(level Level, msg string, fields ...Field) {
+	globalLogger.LogFields(level, msg, fields...)
+}
+
 // Log uses global logger to log payload on the level of the first parameter
 func Log(level Level, args ...any) {
 	globalLogger.Log(level, args...)
@@ -97,6 +123,46 @@ func Logf(level Level, format string, args ...any) {
 	globalLogger.Logf(level, format, args...)
 }
 
+// Infow uses global logger to log payload with alternating keys and values on "info" level
+func Infow(msg string, keysAndValues ...any) {
+	globalLogger.Infow(msg, keysAndValues...)
+}
+
+// Errorw uses global logger to log payload with alternating keys and values on "error" level
+func Errorw(msg string, keysAndValues ...any) {
+	globalLogger.Errorw(msg, keysAndValues...)
+}
+
+// Debugw uses global logger to log payload with alternating keys and values on "debug" level
+func Debugw(msg string, keysAndValues ...any) {
+	globalLogger.Debugw(msg, keysAndValues...)
+}
+
+// Tracew uses global logger to log payload with alternating keys and values on "trace" level
+func Tracew(msg string, keysAndValues ...any) {
+	globalLogger.Tracew(msg, keysAndValues...)
+}
+
+// Warnw uses global logger to log payload with alternating keys and values on "warning" level
+func Warnw(msg string, keysAndValues ...any) {
+	globalLogger.Warnw(msg, keysAndValues...)
+}
+
+// Fatalw uses global logger to log payload with alternating keys and values on "fatal" level
+func Fatalw(msg string, keysAndValues ...any) {
+	globalLogger.Fatalw(msg, keysAndValues...)
+}
+
+// Panicw uses global logger to log payload with alternating keys and values on "panic" level, and then panics
+func Panicw(msg string, keysAndValues ...any) {
+	globalLogger.Panicw(msg, keysAndValues...)
+}
+
+// Logw uses global logger to log payload with alternating keys and values on the level of the first parameter
+func Logw(level Level, msg string, keysAndValues ...any) {
+	globalLogger.Logw(level, msg, keysAndValues...)
+}
+
 // SetNowFunc sets `now` func user by global logger
 func SetNowFunc(nowFunc NowFunc) {
 	ConfigureGlobalLogger(WithNowFunc(nowFunc))
@@ -112,6 +178,11 @@ func SetLevel(level Level) {
 	ConfigureGlobalLogger(WithLevel(level))
 }
 
+// ElevateLevel temporarily sets the minimum log level of the global logger, see Logger.ElevateLevel
+func ElevateLevel(level Level, d time.Duration) (cancel func()) {
+	return globalLogger.ElevateLevel(level, d)
+}
+
 // SetReportCaller allows controlling if caller info should be attached to logs by global logger
 func SetReportCaller(enable bool) {
 	ConfigureGlobalLogger(WithReportCaller(enable))
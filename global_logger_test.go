@@ -17,15 +17,15 @@ func TestGlobalLoggerLogLevels(t *testing.T) {
 
 	buf := &bytes.Buffer{}
 	oldOutput := globalLogger.output
-	oldLevel := globalLogger.level
-	oldExitFunc := globalLogger.logrusLogger.ExitFunc
+	oldLevel := globalLogger.Level()
+	oldExitFunc := globalLogger.exitFunc
 	defer func() {
 		// bring global logger to original state after tests
 		ConfigureGlobalLogger(WithOutput(oldOutput), WithLevel(oldLevel))
-		globalLogger.logrusLogger.ExitFunc = oldExitFunc
+		globalLogger.exitFunc = oldExitFunc
 	}()
-	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelDebug))
-	globalLogger.logrusLogger.ExitFunc = func(int) {}
+	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelTrace))
+	globalLogger.exitFunc = func(int) {}
 
 	testCases := map[string]testCase{
 		"Info() should log with level info": {
@@ -40,6 +40,10 @@ func TestGlobalLoggerLogLevels(t *testing.T) {
 			logFunc:          Debug,
 			expectedLogLevel: "debug",
 		},
+		"Trace() should log with level trace": {
+			logFunc:          Trace,
+			expectedLogLevel: "trace",
+		},
 		"Warn() should log with level warning": {
 			logFunc:          Warn,
 			expectedLogLevel: "warning",
@@ -65,15 +69,15 @@ func TestGlobalLoggerLogLevelsInFormatFuncs(t *testing.T) {
 
 	buf := &bytes.Buffer{}
 	oldOutput := globalLogger.output
-	oldLevel := globalLogger.level
-	oldExitFunc := globalLogger.logrusLogger.ExitFunc
+	oldLevel := globalLogger.Level()
+	oldExitFunc := globalLogger.exitFunc
 	defer func() {
 		// bring global logger to original state after tests
 		ConfigureGlobalLogger(WithOutput(oldOutput), WithLevel(oldLevel))
-		globalLogger.logrusLogger.ExitFunc = oldExitFunc
+		globalLogger.exitFunc = oldExitFunc
 	}()
-	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelDebug))
-	globalLogger.logrusLogger.ExitFunc = func(int) {}
+	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelTrace))
+	globalLogger.exitFunc = func(int) {}
 
 	testCases := map[string]testCase{
 		"Info() should log with level info": {
@@ -88,6 +92,10 @@ func TestGlobalLoggerLogLevelsInFormatFuncs(t *testing.T) {
 			logFunc:          Debugf,
 			expectedLogLevel: "debug",
 		},
+		"Trace() should log with level trace": {
+			logFunc:          Tracef,
+			expectedLogLevel: "trace",
+		},
 		"Warn() should log with level warning": {
 			logFunc:          Warnf,
 			expectedLogLevel: "warning",
@@ -113,15 +121,15 @@ func TestGlobalLoggerLogLevelsInLogFunc(t *testing.T) {
 
 	buf := &bytes.Buffer{}
 	oldOutput := globalLogger.output
-	oldLevel := globalLogger.level
-	oldExitFunc := globalLogger.logrusLogger.ExitFunc
+	oldLevel := globalLogger.Level()
+	oldExitFunc := globalLogger.exitFunc
 	defer func() {
 		// bring global logger to original state after tests
 		ConfigureGlobalLogger(WithOutput(oldOutput), WithLevel(oldLevel))
-		globalLogger.logrusLogger.ExitFunc = oldExitFunc
+		globalLogger.exitFunc = oldExitFunc
 	}()
-	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelDebug))
-	globalLogger.logrusLogger.ExitFunc = func(int) {}
+	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelTrace))
+	globalLogger.exitFunc = func(int) {}
 
 	testCases := map[string]testCase{
 		"Info() should log with level info": {
@@ -136,6 +144,10 @@ func TestGlobalLoggerLogLevelsInLogFunc(t *testing.T) {
 			logLevel:         LevelDebug,
 			expectedLogLevel: "debug",
 		},
+		"Trace() should log with level trace": {
+			logLevel:         LevelTrace,
+			expectedLogLevel: "trace",
+		},
 		"Warn() should log with level warning": {
 			logLevel:         LevelWarn,
 			expectedLogLevel: "warning",
@@ -161,15 +173,15 @@ func TestGlobalLoggerLogLevelsInLogfFunc(t *testing.T) {
 
 	buf := &bytes.Buffer{}
 	oldOutput := globalLogger.output
-	oldLevel := globalLogger.level
-	oldExitFunc := globalLogger.logrusLogger.ExitFunc
+	oldLevel := globalLogger.Level()
+	oldExitFunc := globalLogger.exitFunc
 	defer func() {
 		// bring global logger to original state after tests
 		ConfigureGlobalLogger(WithOutput(oldOutput), WithLevel(oldLevel))
-		globalLogger.logrusLogger.ExitFunc = oldExitFunc
+		globalLogger.exitFunc = oldExitFunc
 	}()
-	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelDebug))
-	globalLogger.logrusLogger.ExitFunc = func(int) {}
+	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelTrace))
+	globalLogger.exitFunc = func(int) {}
 
 	testCases := map[string]testCase{
 		"Info() should log with level info": {
@@ -184,6 +196,10 @@ func TestGlobalLoggerLogLevelsInLogfFunc(t *testing.T) {
 			logLevel:         LevelDebug,
 			expectedLogLevel: "debug",
 		},
+		"Trace() should log with level trace": {
+			logLevel:         LevelTrace,
+			expectedLogLevel: "trace",
+		},
 		"Warn() should log with level warning": {
 			logLevel:         LevelWarn,
 			expectedLogLevel: "warning",
@@ -209,7 +225,7 @@ func TestGlobalLoggerConvenienveFunctions(t *testing.T) {
 		SetOutput(oldOutput)
 		SetNowFunc(oldNowFunc)
 		SetReportCaller(true)
-		SetLevel(globalLogger.level)
+		SetLevel(globalLogger.Level())
 	}()
 	{
 		SetOutput(buf)
@@ -225,10 +241,51 @@ func TestGlobalLoggerConvenienveFunctions(t *testing.T) {
 	assertLogEntryContains(t, buf, "msg", "but now I will")
 }
 
+func TestGlobalLoggerPanic(t *testing.T) {
+	buf := &bytes.Buffer{}
+	oldOutput := globalLogger.output
+	defer SetOutput(oldOutput)
+	SetOutput(buf)
+
+	assert.PanicsWithValue(t, "foobar", func() { Panic("foobar") })
+	assertLogEntryContains(t, buf, "level", "panic")
+
+	assert.PanicsWithValue(t, "foobar", func() { Panicf("foo%s", "bar") })
+	assertLogEntryContains(t, buf, "level", "panic")
+}
+
+func TestGlobalLoggerKeyValueLogging(t *testing.T) {
+	buf := &bytes.Buffer{}
+	oldOutput := globalLogger.output
+	oldLevel := globalLogger.Level()
+	defer ConfigureGlobalLogger(WithOutput(oldOutput), WithLevel(oldLevel))
+	ConfigureGlobalLogger(WithOutput(buf), WithLevel(LevelTrace))
+
+	for level, logFunc := range map[string]func(msg string, keysAndValues ...any){
+		"info":    Infow,
+		"error":   Errorw,
+		"debug":   Debugw,
+		"trace":   Tracew,
+		"warning": Warnw,
+	} {
+		logFunc("foobar", "foo", "bar")
+		b := buf.Bytes()
+		buf.Reset()
+		assertLogEntryContains(t, bytes.NewReader(b), "level", level)
+		assertLogEntryContains(t, bytes.NewReader(b), "foo", "bar")
+	}
+
+	Logw(LevelInfo, "foobar", "foo", "bar")
+	assertLogEntryContains(t, buf, "foo", "bar")
+
+	assert.PanicsWithValue(t, "foobar", func() { Panicw("foobar") })
+	assertLogEntryContains(t, buf, "level", "panic")
+}
+
 func TestChainingSetup(t *testing.T) {
 	buf := &bytes.Buffer{}
 	oldOutput := globalLogger.output
-	oldLevel := globalLogger.level
+	oldLevel := globalLogger.Level()
 	oldNowFunc := globalLogger.now
 	defer func() {
 		// bring global logger to original state after tests
@@ -305,7 +362,7 @@ func TestGlobalLoggerFromEnv(t *testing.T) {
 		SetOutput(oldOutput)
 		SetNowFunc(oldNowFunc)
 		SetReportCaller(true)
-		SetLevel(globalLogger.level)
+		SetLevel(globalLogger.Level())
 	}()
 	{
 		SetOutput(buf)
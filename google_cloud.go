@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// googleCloudKeys are the special fields of Cloud Logging, see
+// https://cloud.google.com/logging/docs/structured-logging#special-payload-fields.
+var googleCloudKeys = Keys{
+	Message: "message",
+	Level:   "severity",
+	Time:    "timestamp",
+	TraceID: `logging\.googleapis\.com/trace`,
+	SpanID:  `logging\.googleapis\.com/spanId`,
+}
+
+const googleCloudSourceLocationKey = "logging.googleapis.com/sourceLocation"
+
+// googleCloudSeverities are the Cloud Logging severities of the levels.
+var googleCloudSeverities = levelNames{
+	LevelFatal: "CRITICAL",
+	LevelError: "ERROR",
+	LevelWarn:  "WARNING",
+	LevelInfo:  "INFO",
+	LevelDebug: "DEBUG",
+	LevelTrace: "DEBUG",
+	LevelPanic: "ALERT",
+}
+
+// WithGoogleCloudProfile writes entries as the structured logs of Google
+// Cloud Logging: the level as severity (DEBUG, INFO, WARNING, ERROR, CRITICAL
+// and ALERT for LevelPanic), the message as message, the time as timestamp and
+// the caller as logging.googleapis.com/sourceLocation. Trace ids returned by
+// the function set with WithTraceContextFunc are written as
+// logging.googleapis.com/trace, in the form projects/PROJECT_ID/traces/TRACE_ID,
+// and logging.googleapis.com/spanId. Use GoogleCloudHTTPRequest to log
+// requests.
+//
+//	logger.ConfigureGlobalLogger(logger.WithGoogleCloudProfile("my-project"))
+func WithGoogleCloudProfile(projectID string) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		for _, opt := range []LoggerOption{
+			WithKeys(googleCloudKeys),
+			WithTimeFormat(TimeFormatRFC3339Nano),
+		} {
+			opt.Apply(l)
+		}
+		l.levelNames = &googleCloudSeverities
+		l.sourceLocation = googleCloudSourceLocationKey
+		l.traceIDPrefix = ""
+		if projectID != "" {
+			l.traceIDPrefix = "projects/" + projectID + "/traces/"
+		}
+	})
+}
+
+// GoogleCloudHTTPRequest returns the httpRequest field Cloud Logging shows
+// requests with, for req answered with status and a body of responseSize bytes
+// in latency.
+func GoogleCloudHTTPRequest(req *http.Request, status int, responseSize int64, latency time.Duration) Field {
+	remoteIP := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remoteIP); err == nil {
+		remoteIP = host
+	}
+	return Object("httpRequest",
+		String("requestMethod", req.Method),
+		String("requestUrl", req.URL.String()),
+		String("requestSize", strconv.FormatInt(max(req.ContentLength, 0), 10)),
+		Int("status", status),
+		String("responseSize", strconv.FormatInt(responseSize, 10)),
+		String("userAgent", req.UserAgent()),
+		String("remoteIp", remoteIP),
+		String("referer", req.Referer()),
+		String("latency", strconv.FormatFloat(latency.Seconds(), 'f', -1, 64)+"s"),
+		String("protocol", req.Proto),
+	)
+}
@@ -0,0 +1,115 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithGoogleCloudProfile(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithGoogleCloudProfile("my-project"), WithTraceContextFunc(testTraceContext))
+	ctx := context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})
+
+	logger.WithContext(ctx).Warn("foobar")
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, "foobar", log["message"])
+	assert.Equal(t, "WARNING", log["severity"])
+	assert.Equal(t, "2020-10-10T10:10:10.001Z", log["timestamp"])
+	assert.Equal(t, "projects/my-project/traces/123", log["logging.googleapis.com/trace"])
+	assert.Equal(t, "456", log["logging.googleapis.com/spanId"])
+	location, ok := log["logging.googleapis.com/sourceLocation"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "github.com/coopnorge/go-logger.TestWithGoogleCloudProfile", location["function"])
+	assert.Regexp(t, `google_cloud_test\.go$`, location["file"])
+	assert.Regexp(t, `^\d+$`, location["line"])
+	for _, key := range []string{"msg", "level", "time", "file", "function"} {
+		assert.NotContains(t, log, key)
+	}
+}
+
+func TestGoogleCloudSeverities(t *testing.T) {
+	testCases := map[Level]string{
+		LevelTrace: "DEBUG",
+		LevelDebug: "DEBUG",
+		LevelInfo:  "INFO",
+		LevelWarn:  "WARNING",
+		LevelError: "ERROR",
+		LevelFatal: "CRITICAL",
+		LevelPanic: "ALERT",
+	}
+	for level, expected := range testCases {
+		t.Run(level.String(), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithLevel(LevelTrace), WithGoogleCloudProfile(""), WithExitFunc(func(int) {}))
+			func() {
+				defer func() { _ = recover() }()
+				logger.Log(level, "foobar")
+			}()
+			assert.Equal(t, expected, decodeLogToMap(t, buf)["severity"])
+		})
+	}
+}
+
+func TestGoogleCloudSourceLocationWithHooks(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithGoogleCloudProfile(""), WithHookFunc(func(*HookEntry) (bool, error) {
+		return false, nil
+	}))
+
+	logger.Warn("foobar")
+
+	location, ok := decodeLogToMap(t, buf)["logging.googleapis.com/sourceLocation"].(map[string]any)
+	require.True(t, ok)
+	assert.Contains(t, location, "function")
+	assert.NotContains(t, location["file"], ":")
+	assert.Regexp(t, `^\d+$`, location["line"])
+}
+
+func TestGoogleCloudProfileLogfmt(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithGoogleCloudProfile("my-project"),
+		WithTraceContextFunc(testTraceContext), WithFormat(FormatLogfmt))
+
+	logger.WithContext(context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})).Warn("foobar")
+	assert.Equal(t, "timestamp=2020-10-10T10:10:10.001Z severity=WARNING message=foobar logging.googleapis.com/spanId=456 logging.googleapis.com/trace=projects/my-project/traces/123\n", buf.String())
+}
+
+func TestDatadogProfileAfterGoogleCloudProfile(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithGoogleCloudProfile("my-project"), WithDatadogProfile("", "", ""))
+
+	logger.Warn("foobar")
+	assert.Equal(t, `{"message":"foobar","status":"warning","time":"2020-10-10T10:10:10.001Z"}`+"\n", buf.String())
+}
+
+func TestGoogleCloudHTTPRequest(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithGoogleCloudProfile(""))
+	req := httptest.NewRequest("POST", "https://example.com/payments?id=42", strings.NewReader("body"))
+	req.Header.Set("User-Agent", "test")
+	req.Header.Set("Referer", "https://example.com/")
+
+	logger.LogFields(LevelWarn, "request", GoogleCloudHTTPRequest(req, 201, 1024, 1500*time.Millisecond))
+
+	assert.Equal(t, map[string]any{
+		"requestMethod": "POST",
+		"requestUrl":    "https://example.com/payments?id=42",
+		"requestSize":   "4",
+		"status":        float64(201),
+		"responseSize":  "1024",
+		"userAgent":     "test",
+		"remoteIp":      "192.0.2.1",
+		"referer":       "https://example.com/",
+		"latency":       "1.5s",
+		"protocol":      "HTTP/1.1",
+	}, decodeLogToMap(t, buf)["httpRequest"])
+}
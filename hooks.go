@@ -2,8 +2,8 @@ package logger
 
 import (
 	"context"
-
-	"github.com/sirupsen/logrus"
+	"fmt"
+	"os"
 )
 
 // Hook defines the interface a custom Hook needs to implement
@@ -24,7 +24,8 @@ type HookEntry struct {
 	// Contains all the fields set by the user.
 	Data Fields
 
-	// Level the log entry was logged at: Trace, Debug, Info, Warn, Error, Fatal or Panic
+	// Level the log entry was logged at: Trace, Debug, Info, Warn, Error, Fatal or Panic.
+	// Compare it with Level.Enables, the numeric values do not follow severity.
 	// This field will be set on entry firing and the value will be equal to the one in Logger struct field.
 	Level Level
 
@@ -35,37 +36,35 @@ type HookEntry struct {
 	Context context.Context
 }
 
-type logrusHook struct {
-	hook Hook
-}
-
-// Levels implements the logrus.Hook interface.
-func (h *logrusHook) Levels() []logrus.Level {
-	return logrus.AllLevels
-}
-
-// Fire implements the logrus.Hook interface.
-func (h *logrusHook) Fire(entry *logrus.Entry) error {
-	// Provide all entry-data so the hook can mutate them.
-	hookEntry := &HookEntry{
-		Data:    Fields(entry.Data),
-		Level:   mapLogrusLevelToLevel(entry.Level),
-		Message: entry.Message,
-		Context: entry.Context,
-	}
-	changed, err := h.hook.Fire(hookEntry)
-	if err != nil {
-		return err
+// fireHooks lets all hooks of the logger inspect and mutate the record.
+func (logger *Logger) fireHooks(r *record) {
+	if len(logger.hooks) == 0 {
+		return
 	}
-	if !changed {
-		return nil
+	r.materialize()
+	added := r.addBuiltinFields()
+	defer r.removeBuiltinFields(added)
+	// Provide all entry-data so the hooks can mutate them.
+	hookEntry := &HookEntry{
+		Data:    r.fields,
+		Level:   r.level,
+		Message: r.message,
+		Context: r.context,
 	}
+	for _, hook := range logger.hooks {
+		changed, err := hook.Fire(hookEntry)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to fire hook: %v\n", err)
+			break
+		}
+		if !changed {
+			continue
+		}
 
-	// Mutate the actual logrus entry with the mutations done in the hook.
-	entry.Data = logrus.Fields(hookEntry.Data)
-	entry.Level = mapLevelToLogrusLevel(hookEntry.Level)
-	entry.Message = hookEntry.Message
-	entry.Context = hookEntry.Context
-
-	return nil
+		// Mutate the actual record with the mutations done in the hook.
+		r.fields = hookEntry.Data
+		r.level = hookEntry.Level
+		r.message = hookEntry.Message
+		r.context = hookEntry.Context
+	}
 }
@@ -0,0 +1,253 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	levelKey   = "level"
+	messageKey = "msg"
+	timeKey    = "time"
+
+	// clashPrefix is prepended to fields that clash with the keys written
+	// by the encoder, so that they are not overwritten.
+	clashPrefix = "fields."
+)
+
+// jsonEncoder writes a record as a single line JSON document with the keys
+// sorted. Errors are written as the string returned by their Error method.
+type jsonEncoder struct {
+	enc *encoding
+}
+
+// fieldsPool holds the slices the encoders collect the fields of a record in.
+var fieldsPool = sync.Pool{
+	New: func() any {
+		fields := make([]Field, 0, 16)
+		return &fields
+	},
+}
+
+func (e jsonEncoder) encode(dst []byte, r *record) []byte {
+	enc := e.enc.orDefault()
+	fieldsPtr := fieldsPool.Get().(*[]Field)
+	fields := r.appendFields((*fieldsPtr)[:0])
+	header := enc.headerFields(r)
+	fields = enc.renameFields(fields, true, header[:]...)
+	dst = appendJSONFields(dst, fields)
+	dst = append(dst, '\n')
+
+	clear(fields)
+	*fieldsPtr = fields[:0]
+	fieldsPool.Put(fieldsPtr)
+	return dst
+}
+
+// appendJSONFields appends fields as a JSON object with the keys sorted. When
+// several fields have the same key, the last one is written. fields is
+// sorted in place.
+func appendJSONFields(dst []byte, fields []Field) []byte {
+	dst = append(dst, '{')
+	for i, field := range sortFields(fields) {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendJSONString(dst, field.key)
+		dst = append(dst, ':')
+		dst = appendJSONField(dst, field)
+	}
+	return append(dst, '}')
+}
+
+// appendJSONField appends the JSON encoding of the value of f to dst.
+func appendJSONField(dst []byte, f Field) []byte {
+	switch f.kind {
+	case stringKind, builtinKind:
+		return appendJSONString(dst, f.str)
+	case int64Kind, durationKind:
+		return strconv.AppendInt(dst, f.integer, 10)
+	case uint64Kind:
+		return strconv.AppendUint(dst, uint64(f.integer), 10)
+	case float64Kind:
+		return appendJSONFloat(dst, math.Float64frombits(uint64(f.integer)), 64)
+	case boolKind:
+		return strconv.AppendBool(dst, f.integer == 1)
+	case timeKind:
+		dst = append(dst, '"')
+		dst = time.Unix(0, f.integer).In(f.value.(*time.Location)).AppendFormat(dst, time.RFC3339Nano)
+		return append(dst, '"')
+	case errorKind:
+		return appendJSONString(dst, f.value.(error).Error())
+	case objectKind:
+		fieldsPtr := fieldsPool.Get().(*[]Field)
+		fields := append((*fieldsPtr)[:0], f.value.([]Field)...)
+		dst = appendJSONFields(dst, fields)
+		clear(fields)
+		*fieldsPtr = fields[:0]
+		fieldsPool.Put(fieldsPtr)
+		return dst
+	case callerFileKind:
+		dst = append(dst, '"')
+		dst = appendJSONStringContent(dst, f.str)
+		dst = append(dst, ':')
+		dst = strconv.AppendInt(dst, f.integer, 10)
+		return append(dst, '"')
+	}
+	return appendJSONValue(dst, f.value)
+}
+
+// appendJSONValue appends the JSON encoding of v to dst. Common types are
+// encoded directly, everything else is passed to encoding/json.
+func appendJSONValue(dst []byte, v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(dst, "null"...)
+	case string:
+		return appendJSONString(dst, v)
+	case bool:
+		return strconv.AppendBool(dst, v)
+	case int:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int8:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int16:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int32:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int64:
+		return strconv.AppendInt(dst, v, 10)
+	case uint:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint8:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint16:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint32:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint64:
+		return strconv.AppendUint(dst, v, 10)
+	case float32:
+		return appendJSONFloat(dst, float64(v), 32)
+	case float64:
+		return appendJSONFloat(dst, v, 64)
+	case time.Duration:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case formattedTime:
+		dst = append(dst, '"')
+		dst = v.time.AppendFormat(dst, v.layout)
+		return append(dst, '"')
+	case slog.LogValuer:
+		return appendJSONValue(dst, resolveValue(v))
+	case error:
+		return appendJSONString(dst, v.Error())
+	case Fields:
+		// The members may hold values implementing slog.LogValuer, which
+		// encoding/json does not resolve.
+		fields := make([]Field, 0, len(v))
+		for key, value := range v {
+			fields = append(fields, Any(key, value))
+		}
+		return appendJSONFields(dst, fields)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return appendJSONString(dst, fmt.Sprintf("%+v", v))
+	}
+	return append(dst, b...)
+}
+
+// appendJSONFloat appends f formatted like encoding/json does. NaN and
+// infinities, which JSON cannot represent, are written as strings.
+func appendJSONFloat(dst []byte, f float64, bits int) []byte {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return appendJSONString(dst, strconv.FormatFloat(f, 'g', -1, bits))
+	}
+
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	dst = strconv.AppendFloat(dst, f, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst
+}
+
+const hex = "0123456789abcdef"
+
+// appendJSONString appends s as a quoted JSON string, escaped the same way as
+// encoding/json does with HTML escaping enabled.
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	dst = appendJSONStringContent(dst, s)
+	return append(dst, '"')
+}
+
+// appendJSONStringContent appends s escaped like appendJSONString, without
+// the quotes.
+func appendJSONStringContent(dst []byte, s string) []byte {
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch b {
+			case '\\', '"':
+				dst = append(dst, '\\', b)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are valid in JSON, but not in JSONP.
+		if c == '\u2028' || c == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[c&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	return append(dst, s[start:]...)
+}
@@ -0,0 +1,95 @@
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logrusLevels maps levels to the logrus levels the encoder must stay
+// compatible with.
+var logrusLevels = map[Level]logrus.Level{
+	LevelFatal: logrus.FatalLevel,
+	LevelError: logrus.ErrorLevel,
+	LevelWarn:  logrus.WarnLevel,
+	LevelInfo:  logrus.InfoLevel,
+	LevelDebug: logrus.DebugLevel,
+	LevelTrace: logrus.TraceLevel,
+	LevelPanic: logrus.PanicLevel,
+}
+
+type jsonMarshalerValue struct{}
+
+func (jsonMarshalerValue) MarshalJSON() ([]byte, error) {
+	return []byte(`{"custom":true}`), nil
+}
+
+func TestJSONEncoderMatchesLogrus(t *testing.T) {
+	now := time.Date(2020, 2, 3, 4, 5, 6, 789012345, time.UTC)
+	testCases := map[string]Fields{
+		"no fields":       {},
+		"string":          {"key": "value"},
+		"escaped string":  {"key": "quote\" backslash\\ newline\n tab\t <html> & \x01 \u2028 \xff"},
+		"unicode":         {"key": "blåbærsyltetøy 🫐"},
+		"bool":            {"key": true},
+		"ints":            {"int": -1, "int8": int8(-8), "int16": int16(16), "int32": int32(32), "int64": int64(math.MaxInt64)},
+		"uints":           {"uint": uint(1), "uint8": uint8(8), "uint16": uint16(16), "uint32": uint32(32), "uint64": uint64(math.MaxUint64)},
+		"floats":          {"float32": float32(1.5), "float64": 0.1, "small": 1e-7, "big": 1e21, "zero": 0.0},
+		"duration":        {"key": 3 * time.Second},
+		"time":            {"key": now},
+		"error":           {"error": errors.New("some error")},
+		"nil":             {"key": nil},
+		"slice":           {"key": []int{1, 2, 3}},
+		"map":             {"key": map[string]any{"b": 1, "a": "<"}},
+		"nested fields":   {"key": Fields{"b": 1, "a": 2}},
+		"json marshaler":  {"key": jsonMarshalerValue{}},
+		"struct":          {"key": struct{ A int }{A: 1}},
+		"clashing fields": {"level": "l", "msg": "m", "time": "t", "file": "f"},
+		"sorting":         {"b": 1, "a": 2, "z": 3, "A": 4, "fields.x": 5},
+	}
+
+	formatter := &logrus.JSONFormatter{TimestampFormat: TimeFormatDefault}
+	for name, fields := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for level, logrusLevel := range logrusLevels {
+				r := &record{time: now, level: level, message: "hello <world>", fields: fields}
+				actual := jsonEncoder{}.encode(nil, r)
+
+				expected, err := formatter.Format(&logrus.Entry{
+					Data:    logrus.Fields(maps.Clone(fields)), // the formatter mutates clashing fields
+					Time:    now,
+					Level:   logrusLevel,
+					Message: "hello <world>",
+				})
+				require.NoError(t, err)
+				assert.Equal(t, string(expected), string(actual))
+			}
+		})
+	}
+}
+
+func TestJSONEncoderUnsupportedValues(t *testing.T) {
+	r := &record{
+		time:  time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC),
+		level: LevelInfo,
+		fields: Fields{
+			"nan":  math.NaN(),
+			"inf":  math.Inf(1),
+			"func": func() {},
+		},
+	}
+	actual := jsonEncoder{}.encode(nil, r)
+
+	log := map[string]any{}
+	require.NoError(t, json.Unmarshal(actual, &log))
+	assert.Equal(t, "NaN", log["nan"])
+	assert.Equal(t, "+Inf", log["inf"])
+	assert.IsType(t, "", log["func"])
+}
@@ -0,0 +1,311 @@
+package logger
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Keys are the keys of the fields written by the logger, see WithKeys. Keys
+// that are left empty keep their default. A key with dots, like
+// "logger.file", is written as a nested object in JSON, and as the dotted key
+// in logfmt and console output. Escape a dot with a backslash to keep it in
+// the key, e.g. `logging\.googleapis\.com/trace`. Fields added with the
+// default key of the error, caller or logger name, e.g. with
+// WithField("error", err), are renamed as well. Fields named like the service
+// and trace fields are not. Other fields with the key of a nested object, like
+// "logger" for "logger.file", are written with the prefix "fields.".
+type Keys struct {
+	// Message is the key of the message, "msg" by default.
+	Message string
+	// Level is the key of the level, "level" by default.
+	Level string
+	// Time is the key of the time of the entry, "time" by default.
+	Time string
+	// Error is the key of the error added with WithError or Err, "error" by
+	// default.
+	Error string
+	// File is the key of the file and line of the caller, "file" by default.
+	File string
+	// Function is the key of the function of the caller, "function" by
+	// default.
+	Function string
+	// Name is the key of the name given with Named, "logger" by default.
+	Name string
+	// Service, Env and Version are the keys of the fields added with
+	// WithService, "service", "env" and "version" by default.
+	Service string
+	Env     string
+	Version string
+	// TraceID and SpanID are the keys of the fields added with
+	// WithTraceContextFunc, "trace_id" and "span_id" by default.
+	TraceID string
+	SpanID  string
+}
+
+// Time formats for WithTimeFormat. Any other layout accepted by time.Format
+// can be used as well.
+const (
+	// TimeFormatDefault is RFC 3339 with milliseconds, the default.
+	TimeFormatDefault = "2006-01-02T15:04:05.999Z07:00"
+	// TimeFormatRFC3339Nano is RFC 3339 with nanoseconds.
+	TimeFormatRFC3339Nano = time.RFC3339Nano
+	// TimeFormatUnixMilli writes the time as the number of milliseconds
+	// since the Unix epoch.
+	TimeFormatUnixMilli = "UNIXMILLI"
+)
+
+// defaultKeys are the keys used when they are not set with WithKeys.
+var defaultKeys = Keys{
+	Message:  messageKey,
+	Level:    levelKey,
+	Time:     timeKey,
+	Error:    errorKey,
+	File:     fileKey,
+	Function: functionKey,
+	Name:     loggerNameKey,
+	Service:  serviceKey,
+	Env:      envKey,
+	Version:  versionKey,
+	TraceID:  traceIDKey,
+	SpanID:   spanIDKey,
+}
+
+// encoding holds the keys and time format the encoders write entries with.
+type encoding struct {
+	keys Keys
+	// timeFormat is the layout of the time, or "" when it is not set with
+	// WithTimeFormat.
+	timeFormat string
+	// levelNames are the names of the levels, when they differ from
+	// Level.outputName.
+	levelNames *levelNames
+	// sourceLocation is the key of an object the caller is written to, with
+	// the fields file, line and function, when set.
+	sourceLocation string
+}
+
+// levelNames are names of the levels, indexed by Level.
+type levelNames [len(outputNames)]string
+
+var defaultEncoding = encoding{keys: defaultKeys}
+
+// newEncoding returns the encoding for keys, with empty keys set to their
+// defaults, and timeFormat.
+func newEncoding(keys Keys, timeFormat string) *encoding {
+	set := func(key *string, def string) {
+		if *key == "" {
+			*key = def
+		}
+	}
+	set(&keys.Message, defaultKeys.Message)
+	set(&keys.Level, defaultKeys.Level)
+	set(&keys.Time, defaultKeys.Time)
+	set(&keys.Error, defaultKeys.Error)
+	set(&keys.File, defaultKeys.File)
+	set(&keys.Function, defaultKeys.Function)
+	set(&keys.Name, defaultKeys.Name)
+	set(&keys.Service, defaultKeys.Service)
+	set(&keys.Env, defaultKeys.Env)
+	set(&keys.Version, defaultKeys.Version)
+	set(&keys.TraceID, defaultKeys.TraceID)
+	set(&keys.SpanID, defaultKeys.SpanID)
+	return &encoding{keys: keys, timeFormat: timeFormat}
+}
+
+// orDefault returns e, or the default encoding when e is nil.
+func (e *encoding) orDefault() *encoding {
+	if e == nil {
+		return &defaultEncoding
+	}
+	return e
+}
+
+// builtinKey returns the configured key of the field f written by go-logger
+// itself with a default key. The error, caller and logger name fields are
+// recognised by their key, so fields added by the user with the same key are
+// renamed as well. The service and trace fields are only renamed when f is a
+// builtinKind field.
+func (e *encoding) builtinKey(f Field) (string, bool) {
+	switch f.key {
+	case errorKey:
+		return e.keys.Error, true
+	case fileKey:
+		return e.keys.File, true
+	case functionKey:
+		return e.keys.Function, true
+	case loggerNameKey:
+		return e.keys.Name, true
+	}
+	if f.kind != builtinKind {
+		return "", false
+	}
+	switch f.key {
+	case serviceKey:
+		return e.keys.Service, true
+	case envKey:
+		return e.keys.Env, true
+	case versionKey:
+		return e.keys.Version, true
+	case traceIDKey:
+		return e.keys.TraceID, true
+	case spanIDKey:
+		return e.keys.SpanID, true
+	}
+	return "", false
+}
+
+// renameFields sets the configured keys on the built-in fields, like the
+// error, caller and logger name, and appends header, the level, message and
+// time fields. The caller is moved into the source location object when set.
+// When clash is set, other fields with the key of a header field get
+// clashPrefix prepended, so they are not overwritten. Built-in fields with
+// dotted keys are nested into objects, and other fields with the key of such
+// an object get clashPrefix prepended as well. fields is changed in place.
+func (e *encoding) renameFields(fields []Field, clash bool, header ...Field) []Field {
+	var nested, location []Field
+	n := 0
+	for _, f := range fields {
+		if e.sourceLocation != "" && (f.key == fileKey || f.key == functionKey) {
+			location = appendSourceLocation(location, f)
+			continue
+		}
+		if key, ok := e.builtinKey(f); ok {
+			if strings.Contains(key, ".") {
+				nested = nestField(nested, key, f)
+				continue
+			}
+			f.key = key
+		} else if clash && (f.key == e.keys.Level || f.key == e.keys.Message || f.key == e.keys.Time) {
+			f.key = clashPrefix + f.key
+		}
+		fields[n] = f
+		n++
+	}
+	clear(fields[n:])
+	fields = fields[:n]
+	for _, f := range header {
+		if strings.Contains(f.key, ".") {
+			nested = nestField(nested, f.key, f)
+			continue
+		}
+		fields = append(fields, f)
+	}
+	for _, object := range nested {
+		if object.kind != objectKind {
+			continue
+		}
+		for i := range fields {
+			if fields[i].key == object.key {
+				fields[i].key = clashPrefix + fields[i].key
+			}
+		}
+	}
+	if location != nil {
+		fields = append(fields, Object(e.sourceLocation, sortFields(location)...))
+	}
+	return append(fields, nested...)
+}
+
+// appendSourceLocation appends the members of the source location object
+// for the caller field f: the file and line of the file field, and the
+// function.
+func appendSourceLocation(location []Field, f Field) []Field {
+	switch {
+	case f.kind == callerFileKind:
+		return append(location, String("file", f.str), String("line", strconv.FormatInt(f.integer, 10)))
+	case f.key == fileKey:
+		// The file field of a materialized record, file:line.
+		file, _ := consoleString(f)
+		if i := strings.LastIndexByte(file, ':'); i >= 0 {
+			return append(location, String("file", file[:i]), String("line", file[i+1:]))
+		}
+		return append(location, String("file", file))
+	}
+	function, _ := consoleString(f)
+	return append(location, String("function", function))
+}
+
+// nestField adds f to fields under the dotted key, creating an object for
+// every part of the key but the last. The objects in fields must not be
+// shared.
+func nestField(fields []Field, key string, f Field) []Field {
+	head, rest, ok := cutKey(key)
+	if !ok {
+		f.key = head
+		return append(fields, f)
+	}
+	for i := range fields {
+		if fields[i].key == head && fields[i].kind == objectKind {
+			fields[i].value = nestField(fields[i].value.([]Field), rest, f)
+			return fields
+		}
+	}
+	return append(fields, Field{key: head, kind: objectKind, value: nestField(nil, rest, f)})
+}
+
+// cutKey slices key around the first dot that is not escaped with a
+// backslash. Escaped dots in head are unescaped.
+func cutKey(key string) (head, rest string, found bool) {
+	for i := 0; i < len(key); i++ {
+		switch key[i] {
+		case '\\':
+			i++
+		case '.':
+			return unescapeKey(key[:i]), key[i+1:], true
+		}
+	}
+	return unescapeKey(key), "", false
+}
+
+// unescapeKey returns key with the escaped dots unescaped, as written when
+// the key is not nested.
+func unescapeKey(key string) string {
+	return strings.ReplaceAll(key, `\.`, ".")
+}
+
+// headerFields returns the level, message and time fields of r.
+func (e *encoding) headerFields(r *record) [3]Field {
+	return [3]Field{
+		String(e.keys.Level, e.levelName(r.level)),
+		String(e.keys.Message, r.message),
+		e.timeField(r.time),
+	}
+}
+
+// levelName returns the name of level.
+func (e *encoding) levelName(level Level) string {
+	if e.levelNames != nil && int(level) < len(e.levelNames) {
+		return e.levelNames[level]
+	}
+	return level.outputName()
+}
+
+// timeField returns the time field for t, in the time format.
+func (e *encoding) timeField(t time.Time) Field {
+	if e.timeFormat == TimeFormatUnixMilli {
+		return Int64(e.keys.Time, t.UnixMilli())
+	}
+	return Any(e.keys.Time, formattedTime{time: t, layout: e.layout()})
+}
+
+// appendTime appends t in the time format, unquoted.
+func (e *encoding) appendTime(dst []byte, t time.Time) []byte {
+	if e.timeFormat == TimeFormatUnixMilli {
+		return strconv.AppendInt(dst, t.UnixMilli(), 10)
+	}
+	return t.AppendFormat(dst, e.layout())
+}
+
+func (e *encoding) layout() string {
+	if e.timeFormat == "" {
+		return TimeFormatDefault
+	}
+	return e.timeFormat
+}
+
+// formattedTime is a time written with layout.
+type formattedTime struct {
+	time   time.Time
+	layout string
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithKeys(t *testing.T) {
+	frame := &runtime.Frame{File: "/app/main.go", Line: 7, Function: "main.main"}
+	r := &record{
+		level:   LevelError,
+		message: "foobar",
+		fields:  Fields{"status": "clash", "level": "no clash", loggerNameKey: "billing"},
+		typed:   []Field{Err(errors.New("some error"))},
+		frame:   frame,
+	}
+
+	testCases := map[string]struct {
+		keys       Keys
+		timeFormat string
+		json       string
+		logfmt     string
+		console    string
+	}{
+		"default": {
+			json:    `{"error":"some error","fields.level":"no clash","file":"/app/main.go:7","function":"main.main","level":"error","logger":"billing","msg":"foobar","status":"clash","time":"2020-10-10T10:10:10.001Z"}`,
+			logfmt:  `time=2020-10-10T10:10:10.001Z level=error msg=foobar error="some error" fields.level="no clash" file=/app/main.go:7 function=main.main logger=billing status=clash`,
+			console: `ERROR 10:10:10.001 foobar                                   app/main.go:7 error="some error" level="no clash" logger=billing status=clash`,
+		},
+		"renamed": {
+			keys:    Keys{Message: "message", Level: "status", Error: "err", Name: "name"},
+			json:    `{"err":"some error","fields.status":"clash","file":"/app/main.go:7","function":"main.main","level":"no clash","message":"foobar","name":"billing","status":"error","time":"2020-10-10T10:10:10.001Z"}`,
+			logfmt:  `time=2020-10-10T10:10:10.001Z status=error message=foobar err="some error" fields.status=clash file=/app/main.go:7 function=main.main level="no clash" name=billing`,
+			console: `ERROR 10:10:10.001 foobar                                   app/main.go:7 err="some error" level="no clash" name=billing status=clash`,
+		},
+		"nested": {
+			keys:    Keys{File: "logger.file", Function: "logger.method_name", Name: "logger.name", Error: "error.message"},
+			json:    `{"error":{"message":"some error"},"fields.level":"no clash","level":"error","logger":{"file":"/app/main.go:7","method_name":"main.main","name":"billing"},"msg":"foobar","status":"clash","time":"2020-10-10T10:10:10.001Z"}`,
+			logfmt:  `time=2020-10-10T10:10:10.001Z level=error msg=foobar error.message="some error" fields.level="no clash" logger.file=/app/main.go:7 logger.method_name=main.main logger.name=billing status=clash`,
+			console: `ERROR 10:10:10.001 foobar                                   app/main.go:7 error.message="some error" level="no clash" logger.name=billing status=clash`,
+		},
+		"nested caller clashes with name": {
+			keys:    Keys{File: "logger.file", Function: "logger.function"},
+			json:    `{"error":"some error","fields.level":"no clash","fields.logger":"billing","level":"error","logger":{"file":"/app/main.go:7","function":"main.main"},"msg":"foobar","status":"clash","time":"2020-10-10T10:10:10.001Z"}`,
+			logfmt:  `time=2020-10-10T10:10:10.001Z level=error msg=foobar error="some error" fields.level="no clash" fields.logger=billing logger.file=/app/main.go:7 logger.function=main.main status=clash`,
+			console: `ERROR 10:10:10.001 foobar                                   app/main.go:7 error="some error" level="no clash" logger=billing status=clash`,
+		},
+		"RFC3339Nano": {
+			keys:       Keys{Time: "timestamp"},
+			timeFormat: TimeFormatRFC3339Nano,
+			json:       `{"error":"some error","file":"/app/main.go:7","function":"main.main","level":"error","logger":"billing","msg":"foobar","status":"clash","fields.level":"no clash","timestamp":"2020-10-10T10:10:10.001Z"}`,
+			logfmt:     `timestamp=2020-10-10T10:10:10.001Z level=error msg=foobar error="some error" fields.level="no clash" file=/app/main.go:7 function=main.main logger=billing status=clash`,
+			console:    `ERROR 2020-10-10T10:10:10.001Z foobar                                   app/main.go:7 error="some error" level="no clash" logger=billing status=clash`,
+		},
+		"Unix millis": {
+			timeFormat: TimeFormatUnixMilli,
+			json:       `{"error":"some error","fields.level":"no clash","file":"/app/main.go:7","function":"main.main","level":"error","logger":"billing","msg":"foobar","status":"clash","time":1602324610001}`,
+			logfmt:     `time=1602324610001 level=error msg=foobar error="some error" fields.level="no clash" file=/app/main.go:7 function=main.main logger=billing status=clash`,
+			console:    `ERROR 1602324610001 foobar                                   app/main.go:7 error="some error" level="no clash" logger=billing status=clash`,
+		},
+		"custom layout": {
+			timeFormat: "2006-01-02 15:04:05",
+			json:       `{"error":"some error","fields.level":"no clash","file":"/app/main.go:7","function":"main.main","level":"error","logger":"billing","msg":"foobar","status":"clash","time":"2020-10-10 10:10:10"}`,
+			logfmt:     `time="2020-10-10 10:10:10" level=error msg=foobar error="some error" fields.level="no clash" file=/app/main.go:7 function=main.main logger=billing status=clash`,
+			console:    `ERROR 2020-10-10 10:10:10 foobar                                   app/main.go:7 error="some error" level="no clash" logger=billing status=clash`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r.time = mockNowFunc()
+			enc := newEncoding(tc.keys, tc.timeFormat)
+			assert.JSONEq(t, tc.json, string(jsonEncoder{enc: enc}.encode(nil, r)))
+			assert.Equal(t, tc.logfmt+"\n", string(logfmtEncoder{enc: enc}.encode(nil, r)))
+			assert.Equal(t, tc.console+"\n", string(consoleEncoder{enc: enc}.encode(nil, r)))
+		})
+	}
+}
+
+func TestWithKeysLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithErrorFields(true),
+		WithKeys(Keys{Message: "message", Level: "status"}), WithTimeFormat(TimeFormatUnixMilli))
+
+	logger.Named("billing").WithError(errors.New("some error")).Error("foobar")
+	assert.Equal(t, `{"error":{"kind":"*errors.errorString","message":"some error"},"logger":"billing","message":"foobar","status":"error","time":1602324610001}`+"\n", buf.String())
+}
+
+func TestWithKeysNestedClash(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithDatadogProfile("", "", ""), WithTraceContextFunc(testTraceContext))
+	ctx := context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})
+
+	logger.WithContext(ctx).WithField("dd", "user value").Warn("foobar")
+	assert.Equal(t, `{"dd":{"span_id":"456","trace_id":"123"},"fields.dd":"user value","message":"foobar","status":"warning","time":"2020-10-10T10:10:10.001Z"}`+"\n", buf.String())
+}
@@ -1,12 +1,15 @@
 package logger
 
 import (
+	"log/slog"
 	"strings"
-
-	"github.com/sirupsen/logrus"
+	"sync/atomic"
 )
 
-// Level is an integer representation of the logging level
+// Level is an integer representation of the logging level. The numeric values
+// do not follow severity: LevelPanic is more severe than LevelFatal, but was
+// added after LevelOff. Use Enables to compare levels, e.g.
+// LevelError.Enables(entry.Level) to select errors in a hook.
 type Level uint8
 
 const (
@@ -20,14 +23,90 @@ const (
 	LevelInfo
 	// LevelDebug should only be used in dev/test environments.
 	LevelDebug
+	// LevelTrace is to be used for very verbose output below debug, eg SQL traces or wire-dumps.
+	LevelTrace
+	// LevelOff is only to be used as minimum level, to silence a logger. Nothing is logged, and no hooks are fired.
+	LevelOff
+	// LevelPanic is to be used for errors the current goroutine cannot continue from. After logging, panic is called with the message, so deferred functions run and the panic can be recovered.
+	LevelPanic
 )
 
 var nameMapping = map[string]Level{
+	"panic": LevelPanic,
 	"fatal": LevelFatal,
 	"error": LevelError,
 	"warn":  LevelWarn,
 	"info":  LevelInfo,
 	"debug": LevelDebug,
+	"trace": LevelTrace,
+	"off":   LevelOff,
+}
+
+// String returns the name of the level, as accepted by LevelNameToLevel.
+func (l Level) String() string {
+	for name, level := range nameMapping {
+		if level == l {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// Enables reports whether entries at level pass the minimum level l, that is
+// whether level is at least as severe as l. LevelOff enables nothing.
+func (l Level) Enables(level Level) bool {
+	if l == LevelOff || level == LevelOff {
+		return false
+	}
+	return level.severity() <= l.severity()
+}
+
+// severity orders the levels from the most severe, which is the lowest.
+// LevelPanic was added after the other levels, but is more severe than
+// LevelFatal.
+func (l Level) severity() int {
+	if l == LevelPanic {
+		return -1
+	}
+	return int(l)
+}
+
+// levelVar is a minimum level that can be read and changed concurrently with
+// logging. The zero value is unset.
+type levelVar struct {
+	// v holds the level plus one, so that zero means unset.
+	v atomic.Uint32
+}
+
+func (v *levelVar) get() (Level, bool) {
+	l := v.v.Load()
+	if l == 0 {
+		return 0, false
+	}
+	return Level(l - 1), true
+}
+
+func (v *levelVar) set(l Level) {
+	v.v.Store(uint32(l) + 1)
+}
+
+// outputNames are the names written to the output for each level.
+var outputNames = [...]string{
+	LevelFatal: "fatal",
+	LevelError: "error",
+	LevelWarn:  "warning",
+	LevelInfo:  "info",
+	LevelDebug: "debug",
+	LevelTrace: "trace",
+	LevelPanic: "panic",
+}
+
+// outputName returns the name written to the output for the level.
+func (l Level) outputName() string {
+	if int(l) < len(outputNames) {
+		return outputNames[l]
+	}
+	return "unknown"
 }
 
 // LevelNameToLevel converts a named log level to the Level type
@@ -36,36 +115,37 @@ func LevelNameToLevel(name string) (l Level, ok bool) {
 	return
 }
 
-func mapLevelToLogrusLevel(l Level) logrus.Level {
+func mapSlogLevelToLevel(l slog.Level) Level {
+	switch {
+	case l >= slog.LevelError:
+		return LevelError
+	case l >= slog.LevelWarn:
+		return LevelWarn
+	case l >= slog.LevelInfo:
+		return LevelInfo
+	case l >= slog.LevelDebug:
+		return LevelDebug
+	}
+	return LevelTrace
+}
+
+func mapLevelToSlogLevel(l Level) slog.Level {
 	switch l {
+	case LevelPanic:
+		return slog.LevelError + 8
 	case LevelFatal:
-		return logrus.FatalLevel
+		return slog.LevelError + 4
 	case LevelError:
-		return logrus.ErrorLevel
+		return slog.LevelError
 	case LevelWarn:
-		return logrus.WarnLevel
+		return slog.LevelWarn
 	case LevelInfo:
-		return logrus.InfoLevel
+		return slog.LevelInfo
 	case LevelDebug:
-		return logrus.DebugLevel
-	}
-	// should never get here
-	return logrus.DebugLevel
-}
-
-func mapLogrusLevelToLevel(l logrus.Level) Level {
-	switch l {
-	case logrus.FatalLevel:
-		return LevelFatal
-	case logrus.ErrorLevel:
-		return LevelError
-	case logrus.WarnLevel:
-		return LevelWarn
-	case logrus.InfoLevel:
-		return LevelInfo
-	case logrus.DebugLevel:
-		return LevelDebug
+		return slog.LevelDebug
+	case LevelTrace:
+		return slog.LevelDebug - 4
 	}
 	// should never get here
-	return LevelDebug
+	return slog.LevelDebug
 }
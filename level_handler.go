@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// levelPayload is the JSON document read and written by the level handler.
+type levelPayload struct {
+	Level string `json:"level"`
+}
+
+// NewLevelHandler returns an http.Handler that reads and changes the minimum
+// level of logger at runtime.
+//
+// A GET request responds with the current level, e.g. {"level":"info"}. A PUT
+// or POST request with the same document as body changes the level, using the
+// names accepted by LevelNameToLevel, and responds with the new level.
+//
+//	http.Handle("/admin/log-level", logger.NewLevelHandler(logger.Global()))
+func NewLevelHandler(logger *Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var payload levelPayload
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				writeLevelHandlerError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+				return
+			}
+			level, ok := LevelNameToLevel(payload.Level)
+			if !ok {
+				writeLevelHandlerError(w, http.StatusBadRequest, fmt.Sprintf("unknown level %q", payload.Level))
+				return
+			}
+			previous := logger.Level()
+			logger.SetLevel(level)
+			logger.WithFields(Fields{"previous_level": previous.String(), "new_level": level.String()}).Warn("Log level changed")
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			writeLevelHandlerError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		writeLevelHandlerJSON(w, http.StatusOK, levelPayload{Level: logger.Level().String()})
+	})
+}
+
+func writeLevelHandlerError(w http.ResponseWriter, status int, msg string) {
+	writeLevelHandlerJSON(w, status, map[string]string{"error": msg})
+}
+
+func writeLevelHandlerJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// NewLevelMiddleware returns HTTP middleware that logs a request at the level
+// named in the request header, by setting it on the request context with
+// ContextWithLevel. Requests without the header, or with an unknown level, are
+// passed on unchanged. Only entries logged with the request context, e.g.
+// logger.WithContext(r.Context()), are affected.
+//
+// The header must only be set by trusted parties: strip it from requests
+// coming from the internet at the edge of the network.
+//
+//	handler = logger.NewLevelMiddleware("X-Debug-Log-Level")(handler)
+func NewLevelMiddleware(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if level, ok := LevelNameToLevel(r.Header.Get(header)); ok {
+				r = r.WithContext(ContextWithLevel(r.Context(), level))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
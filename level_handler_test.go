@@ -0,0 +1,111 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevelHandler(t *testing.T) {
+	testCases := map[string]struct {
+		method         string
+		body           string
+		expectedStatus int
+		expectedBody   string
+		expectedLevel  Level
+	}{
+		"get returns the current level": {
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"level":"warn"}`,
+			expectedLevel:  LevelWarn,
+		},
+		"put changes the level": {
+			method:         http.MethodPut,
+			body:           `{"level":"debug"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"level":"debug"}`,
+			expectedLevel:  LevelDebug,
+		},
+		"post changes the level": {
+			method:         http.MethodPost,
+			body:           `{"level":"ERROR"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"level":"error"}`,
+			expectedLevel:  LevelError,
+		},
+		"unknown level is rejected": {
+			method:         http.MethodPut,
+			body:           `{"level":"verbose"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"unknown level \"verbose\""}`,
+			expectedLevel:  LevelWarn,
+		},
+		"invalid body is rejected": {
+			method:         http.MethodPut,
+			body:           `debug`,
+			expectedStatus: http.StatusBadRequest,
+			expectedLevel:  LevelWarn,
+		},
+		"other methods are not allowed": {
+			method:         http.MethodDelete,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedBody:   `{"error":"method not allowed"}`,
+			expectedLevel:  LevelWarn,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			logger := New(WithOutput(&bytes.Buffer{}))
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, "/log-level", strings.NewReader(tc.body))
+
+			NewLevelHandler(logger).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			if tc.expectedBody != "" {
+				assert.JSONEq(t, tc.expectedBody, rec.Body.String())
+			}
+			assert.Equal(t, tc.expectedLevel, logger.Level())
+		})
+	}
+}
+
+func TestLevelHandlerAffectsChildLoggers(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf))
+	child := logger.Named("billing")
+
+	req := httptest.NewRequest(http.MethodPut, "/log-level", strings.NewReader(`{"level":"debug"}`))
+	NewLevelHandler(logger).ServeHTTP(httptest.NewRecorder(), req)
+	buf.Reset()
+
+	child.Debug("foobar")
+	assertLogEntryContains(t, buf, "logger", "billing")
+}
+
+func TestSetLevelWhileLogging(t *testing.T) {
+	logger := New(WithOutput(io.Discard))
+	child := logger.With(String("foo", "bar"))
+
+	wg := sync.WaitGroup{}
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				child.Debug("foobar")
+				child.Warn("foobar")
+			}
+		})
+	}
+	for _, level := range []Level{LevelDebug, LevelInfo, LevelError, LevelWarn} {
+		logger.SetLevel(level)
+	}
+	wg.Wait()
+}
@@ -2,44 +2,91 @@ package logger
 
 import (
 	"testing"
-
-	"github.com/sirupsen/logrus"
 )
 
-func TestMapLevelToLogrusLevel(t *testing.T) {
+func TestLevelOutputName(t *testing.T) {
 	type testCase struct {
 		input          Level
-		expectedOutput logrus.Level
+		expectedOutput string
 	}
 	testCases := map[string]testCase{
-		"map LevelFatal": {
+		"name LevelFatal": {
 			input:          LevelFatal,
-			expectedOutput: logrus.FatalLevel,
+			expectedOutput: "fatal",
 		},
 
-		"map LevelError": {
+		"name LevelError": {
 			input:          LevelError,
-			expectedOutput: logrus.ErrorLevel,
+			expectedOutput: "error",
 		},
-		"map LevelWarn": {
+		"name LevelWarn": {
 			input:          LevelWarn,
-			expectedOutput: logrus.WarnLevel,
+			expectedOutput: "warning",
 		},
-		"map LevelInfo": {
+		"name LevelInfo": {
 			input:          LevelInfo,
-			expectedOutput: logrus.InfoLevel,
+			expectedOutput: "info",
 		},
-		"map levelDebug": {
+		"name levelDebug": {
 			input:          LevelDebug,
-			expectedOutput: logrus.DebugLevel,
+			expectedOutput: "debug",
+		},
+		"name LevelTrace": {
+			input:          LevelTrace,
+			expectedOutput: "trace",
+		},
+		"name LevelPanic": {
+			input:          LevelPanic,
+			expectedOutput: "panic",
+		},
+		"name unknown level": {
+			input:          Level(100),
+			expectedOutput: "unknown",
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			res := mapLevelToLogrusLevel(tc.input)
+			res := tc.input.outputName()
 			if res != tc.expectedOutput {
-				t.Fatalf("expected %v to map to %v, got: %v", tc.input, tc.expectedOutput, res)
+				t.Fatalf("expected %v to have name %v, got: %v", tc.input, tc.expectedOutput, res)
+			}
+		})
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	for name, level := range nameMapping {
+		if level.String() != name {
+			t.Fatalf("expected %v to have name %v, got: %v", level, name, level.String())
+		}
+	}
+	if Level(100).String() != "unknown" {
+		t.Fatalf("expected unknown level to have name unknown, got: %v", Level(100).String())
+	}
+}
+
+func TestLevelEnables(t *testing.T) {
+	type testCase struct {
+		min, level Level
+		expected   bool
+	}
+	testCases := map[string]testCase{
+		"same level":               {min: LevelWarn, level: LevelWarn, expected: true},
+		"more severe level":        {min: LevelWarn, level: LevelError, expected: true},
+		"less severe level":        {min: LevelWarn, level: LevelInfo, expected: false},
+		"panic is an error":        {min: LevelError, level: LevelPanic, expected: true},
+		"panic is above fatal":     {min: LevelFatal, level: LevelPanic, expected: true},
+		"fatal is below panic":     {min: LevelPanic, level: LevelFatal, expected: false},
+		"off enables nothing":      {min: LevelOff, level: LevelPanic, expected: false},
+		"off is never enabled":     {min: LevelTrace, level: LevelOff, expected: false},
+		"trace enables everything": {min: LevelTrace, level: LevelPanic, expected: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if res := tc.min.Enables(tc.level); res != tc.expected {
+				t.Fatalf("expected %v.Enables(%v) to be %v, got: %v", tc.min, tc.level, tc.expected, res)
 			}
 		})
 	}
@@ -0,0 +1,164 @@
+package logger
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// logfmtEncoder writes a record as a single logfmt line, with the same fields
+// as the JSON encoder. The time, level and message come first, followed by
+// the other fields sorted by key. Nested Fields and objects are flattened to
+// dotted keys, e.g. user.id=42.
+type logfmtEncoder struct {
+	enc *encoding
+}
+
+func (e logfmtEncoder) encode(dst []byte, r *record) []byte {
+	enc := e.enc.orDefault()
+	fieldsPtr := fieldsPool.Get().(*[]Field)
+	fields := r.appendFields((*fieldsPtr)[:0])
+	fields = enc.renameFields(fields, true)
+
+	dst = appendLogfmtKey(dst, unescapeKey(enc.keys.Time))
+	dst = append(dst, '=')
+	start := len(dst)
+	dst = enc.appendTime(dst, r.time)
+	if logfmtNeedsQuoting(string(dst[start:])) {
+		// Custom layouts may contain spaces.
+		dst = appendLogfmtString(dst[:start], string(dst[start:]))
+	}
+	dst = append(dst, ' ')
+	dst = appendLogfmtKey(dst, unescapeKey(enc.keys.Level))
+	dst = append(dst, '=')
+	dst = appendLogfmtString(dst, enc.levelName(r.level))
+	dst = append(dst, ' ')
+	dst = appendLogfmtKey(dst, unescapeKey(enc.keys.Message))
+	dst = append(dst, '=')
+	dst = appendLogfmtString(dst, r.message)
+	dst = appendLogfmtFields(dst, "", sortFields(fields))
+	dst = append(dst, '\n')
+
+	clear(fields)
+	*fieldsPtr = fields[:0]
+	fieldsPool.Put(fieldsPtr)
+	return dst
+}
+
+// appendLogfmtFields appends the sorted fields as key=value pairs, each
+// preceded by a space. Keys of nested fields are prefixed with prefix.
+func appendLogfmtFields(dst []byte, prefix string, fields []Field) []byte {
+	for _, f := range fields {
+		f = resolved(f)
+		key := prefix + f.key
+		if members, ok := nestedFields(f); ok {
+			dst = appendLogfmtFields(dst, key+".", members)
+			continue
+		}
+		dst = append(dst, ' ')
+		dst = appendLogfmtKey(dst, key)
+		dst = append(dst, '=')
+		dst = appendLogfmtValue(dst, f)
+	}
+	return dst
+}
+
+// appendLogfmtValue appends the value of f. Strings are quoted when needed,
+// numbers, booleans and null are written like in JSON, and other values are
+// written as quoted JSON.
+func appendLogfmtValue(dst []byte, f Field) []byte {
+	switch f.kind {
+	case stringKind, builtinKind:
+		return appendLogfmtString(dst, f.str)
+	case errorKind:
+		return appendLogfmtString(dst, f.value.(error).Error())
+	case timeKind:
+		return time.Unix(0, f.integer).In(f.value.(*time.Location)).AppendFormat(dst, time.RFC3339Nano)
+	case callerFileKind:
+		return appendLogfmtString(dst, f.str+":"+strconv.FormatInt(f.integer, 10))
+	case anyKind:
+		switch v := resolveValue(f.value).(type) {
+		case string:
+			return appendLogfmtString(dst, v)
+		case error:
+			return appendLogfmtString(dst, v.Error())
+		}
+	}
+
+	encoded := appendJSONField(nil, f)
+	switch encoded[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(encoded, &s); err == nil {
+			return appendLogfmtString(dst, s)
+		}
+	case '{', '[':
+		return appendLogfmtString(dst, string(encoded))
+	}
+	return append(dst, encoded...)
+}
+
+// appendLogfmtKey appends key, with characters that are not allowed in a
+// logfmt key replaced by '_'.
+func appendLogfmtKey(dst []byte, key string) []byte {
+	if key == "" {
+		return append(dst, '_')
+	}
+	for _, c := range key {
+		if c <= ' ' || c == '=' || c == '"' || c == utf8.RuneError {
+			c = '_'
+		}
+		dst = utf8.AppendRune(dst, c)
+	}
+	return dst
+}
+
+// appendLogfmtString appends s, quoted and escaped like a JSON string when it
+// is empty or contains spaces, quotes, '=', control characters or invalid
+// UTF-8.
+func appendLogfmtString(dst []byte, s string) []byte {
+	if !logfmtNeedsQuoting(s) {
+		return append(dst, s...)
+	}
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c >= ' ' && c != '"' && c != '\\' && c != 0x7f && c != utf8.RuneError {
+			i += size
+			continue
+		}
+		dst = append(dst, s[start:i]...)
+		switch c {
+		case '"', '\\':
+			dst = append(dst, '\\', byte(c))
+		case '\n':
+			dst = append(dst, '\\', 'n')
+		case '\r':
+			dst = append(dst, '\\', 'r')
+		case '\t':
+			dst = append(dst, '\\', 't')
+		case utf8.RuneError:
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+		default:
+			dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+		}
+		i += size
+		start = i
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+func logfmtNeedsQuoting(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, c := range s {
+		if c <= ' ' || c == '=' || c == '"' || c == utf8.RuneError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogfmtEncoder(t *testing.T) {
+	testCases := map[string]struct {
+		record   *record
+		expected string
+	}{
+		"message only": {
+			record:   &record{level: LevelInfo, message: "foobar"},
+			expected: "time=2020-10-10T10:10:10.001Z level=info msg=foobar\n",
+		},
+		"quoting": {
+			record: &record{level: LevelWarn, message: "payment declined", fields: Fields{
+				"empty":   "",
+				"equals":  "a=b",
+				"quote":   `say "hi"`,
+				"newline": "first\nsecond",
+				"control": "bell\a",
+				"path":    `C:\app`,
+				"unicode": "blåbær",
+				"invalid": "\xff",
+			}},
+			expected: `time=2020-10-10T10:10:10.001Z level=warning msg="payment declined" control="bell\u0007" empty="" equals="a=b" invalid="` + "\ufffd" + `" newline="first\nsecond" path=C:\app quote="say \"hi\"" unicode=blåbær` + "\n",
+		},
+		"values": {
+			record: &record{level: LevelInfo, message: "foobar", typed: []Field{
+				Int64("int", -42),
+				Uint64("uint", 42),
+				Float64("float", 1.5),
+				Bool("bool", true),
+				Duration("duration", time.Second),
+				Time("time", time.Date(2020, 10, 10, 10, 10, 10, 0, time.UTC)),
+				Err(errors.New("some error")),
+				Any("nil", nil),
+				Any("list", []string{"a", "b"}),
+			}},
+			expected: `time=2020-10-10T10:10:10.001Z level=info msg=foobar bool=true duration=1000000000 error="some error" fields.time=2020-10-10T10:10:10Z float=1.5 int=-42 list="[\"a\",\"b\"]" nil=null uint=42` + "\n",
+		},
+		"nested fields": {
+			record: &record{level: LevelInfo, message: "foobar", fields: Fields{"user": Fields{"name": "peter", "id": 7}}, typed: []Field{
+				Object("order", String("id", "abc"), Object("total", Int64("amount", 42))),
+			}},
+			expected: "time=2020-10-10T10:10:10.001Z level=info msg=foobar order.id=abc order.total.amount=42 user.id=7 user.name=peter\n",
+		},
+		"keys": {
+			record:   &record{level: LevelInfo, message: "foobar", fields: Fields{"with space": 1, "msg": "clash", "": 2}},
+			expected: "time=2020-10-10T10:10:10.001Z level=info msg=foobar _=2 fields.msg=clash with_space=1\n",
+		},
+		"caller": {
+			record:   &record{level: LevelError, message: "foobar", frame: &runtime.Frame{File: "/app/main.go", Line: 7, Function: "main.main"}},
+			expected: "time=2020-10-10T10:10:10.001Z level=error msg=foobar file=/app/main.go:7 function=main.main\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.record.time = mockNowFunc()
+			assert.Equal(t, tc.expected, string(logfmtEncoder{}.encode(nil, tc.record)))
+		})
+	}
+}
+
+func TestLogfmtFormat(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithFormat(FormatLogfmt), WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	logger.Warnw("payment declined", "order_id", 42, "reason", "card expired")
+	assert.Equal(t, `time=2020-10-10T10:10:10.001Z level=warning msg="payment declined" order_id=42 reason="card expired"`+"\n", buf.String())
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"io"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"time"
-
-	"github.com/sirupsen/logrus"
 )
 
 // Fields type, used to pass to `WithFields`.
@@ -17,43 +18,177 @@ type NowFunc func() time.Time
 
 // Logger is our logger with the needed structured logger we use
 type Logger struct {
-	logrusLogger *logrus.Logger
+	*core
+
+	// parent is the logger this logger was derived from, nil for loggers
+	// created with New.
+	parent *Logger
+	// fields are added to every entry logged through the logger, with the
+	// lowest precedence.
+	fields []Field
+	// name is the dot separated name given with Named.
+	name string
+	// level is the minimum level of the logger. It is unset for loggers that
+	// follow the level of their parent.
+	level levelVar
+	// elevation tracks a temporary level set with ElevateLevel.
+	elevation elevation
+}
+
+// core is the state shared between a logger and all loggers derived from it.
+type core struct {
+	backend      backend
+	hooks        []Hook
 	now          NowFunc
 	output       io.Writer
-	level        Level
+	mu           sync.Mutex // serialises writes to output
 	reportCaller bool
+	callerSkip   int
+	format       Format
+	formatSet    bool // format is set with WithFormat
+	keys         Keys
+	timeFormat   string
+	// service, env and version are added to every entry, see WithService.
+	service, env, version string
+	traceContext          TraceContextFunc
+	// traceIDPrefix is prepended to trace ids, see WithGoogleCloudProfile.
+	traceIDPrefix string
+	// levelNames and sourceLocation are set by profiles, see encoding.
+	levelNames     *levelNames
+	sourceLocation string
+	errorFields    bool
+	exitFunc       func(code int)
+	// stacktraceLevel is the minimum level of entries with a stack trace.
+	stacktraceLevel  Level
+	stacktraceFormat StacktraceFormat
+	// levelOverrides are minimum levels by logger name, see WithLevelOverrides.
+	levelOverrides map[string]Level
 }
 
 func (logger *Logger) applyOptions(opts ...LoggerOption) {
 	for _, opt := range opts {
 		opt.Apply(logger)
 	}
-	logger.logrusLogger.SetOutput(logger.output)
-	logger.logrusLogger.SetLevel(mapLevelToLogrusLevel(logger.level))
+	logger.updateEncoder()
 }
 
 // New creates and returns a new logger with supplied options
 func New(opts ...LoggerOption) *Logger {
-	logrusLogger := logrus.New()
-	logrusLogger.SetFormatter(&logrus.JSONFormatter{
-		TimestampFormat: "2006-01-02T15:04:05.999Z07:00", // Adds milliseconds to the time-output
-	})
-	logger := &Logger{
-		logrusLogger: logrusLogger,
+	c := &core{
 		now:          NowFunc(time.Now),
 		output:       os.Stdout,
-		level:        LevelWarn,
 		reportCaller: true,
+		exitFunc:     os.Exit,
+
+		stacktraceLevel: LevelOff,
 	}
+	c.backend = &encoderBackend{core: c, encoder: jsonEncoder{}}
+	logger := &Logger{core: c}
+	logger.level.set(LevelWarn)
 	logger.applyOptions(opts...)
 	return logger
 }
 
+// With returns a child logger that adds fields to every entry. The child
+// shares output, hooks and level with its parent, so it can be passed to
+// anything that takes a *Logger, such as the adapters. Fields added to an
+// entry, e.g. with WithField, take precedence over the fields of the logger.
+//
+//	payments := logger.Global().With(logger.String("component", "payments"), logger.Any("limits", limits))
+func (logger *Logger) With(fields ...Field) *Logger {
+	child := logger.child()
+	child.fields = slices.Concat(logger.fields, fields)
+	return child
+}
+
+// WithMinLevel returns a child logger that filters entries on its own minimum
+// level instead of following the level of its parent.
+func (logger *Logger) WithMinLevel(level Level) *Logger {
+	child := logger.child()
+	child.level.set(level)
+	return child
+}
+
+// Named returns a child logger that adds its name as the "logger" field to
+// every entry. Names of nested loggers are joined with dots, so
+// logger.Named("billing").Named("invoice") is named "billing.invoice". The
+// level of named loggers can be overridden with WithLevelOverrides.
+func (logger *Logger) Named(name string) *Logger {
+	if name == "" {
+		return logger
+	}
+	if logger.name != "" {
+		name = logger.name + "." + name
+	}
+	child := logger.With(String(loggerNameKey, name))
+	child.name = name
+	return child
+}
+
+func (logger *Logger) child() *Logger {
+	return &Logger{
+		core:   logger.core,
+		parent: logger,
+		fields: logger.fields,
+		name:   logger.name,
+	}
+}
+
+// Level returns the minimum level of the logger. For child loggers this is
+// the level they follow unless one was set with WithMinLevel or SetLevel.
+func (logger *Logger) Level() Level {
+	for l := logger; ; l = l.parent {
+		if level, ok := l.level.get(); ok {
+			return level
+		}
+		if level, ok := l.levelOverride(); ok {
+			return level
+		}
+	}
+}
+
+// SetLevel changes the minimum level of the logger. It is safe to call while
+// the logger is in use. Child loggers that follow the level of this logger
+// are affected too.
+func (logger *Logger) SetLevel(level Level) {
+	logger.level.set(level)
+}
+
+// levelOverride returns the override for the part of the name the logger
+// added to the name of its parent, preferring the longest matching name.
+func (logger *Logger) levelOverride() (Level, bool) {
+	overrides := logger.levelOverrides
+	if len(overrides) == 0 || logger.parent == nil || logger.name == logger.parent.name {
+		return 0, false
+	}
+	for name := logger.name; len(name) > len(logger.parent.name); {
+		if level, ok := overrides[name]; ok {
+			return level, true
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i == -1 {
+			break
+		}
+		name = name[:i]
+	}
+	return 0, false
+}
+
+// enabled reports whether entries at level pass the minimum level of the logger.
+func (logger *Logger) enabled(level Level) bool {
+	return logger.Level().Enables(level)
+}
+
 func (logger *Logger) entry() *Entry {
-	return &Entry{logger: logger, fields: Fields{}}
+	return &Entry{logger: logger}
 }
 
-const errorKey = "error"
+const (
+	errorKey      = "error"
+	loggerNameKey = "logger"
+	fileKey       = "file"
+	functionKey   = "function"
+)
 
 // WithError is a convenience wrapper for WithField("error", err)
 func (logger *Logger) WithError(err error) *Entry {
@@ -75,7 +210,8 @@ func (logger *Logger) WithContext(ctx context.Context) *Entry {
 	return logger.entry().WithContext(ctx)
 }
 
-// OutputHandler returns logger output handler
+// OutputHandler returns logger output handler. It is not used when the logger
+// writes through a slog.Handler configured with WithSlogHandler.
 func (logger *Logger) OutputHandler() io.Writer {
 	return logger.output
 }
@@ -110,6 +246,16 @@ func (logger *Logger) Debugf(format string, args ...any) {
 	logger.entry().Debugf(format, args...)
 }
 
+// Trace forwards a tracing logging call
+func (logger *Logger) Trace(args ...any) {
+	logger.entry().Trace(args...)
+}
+
+// Tracef forwards a tracing logging call
+func (logger *Logger) Tracef(format string, args ...any) {
+	logger.entry().Tracef(format, args...)
+}
+
 // Warn forwards a warning logging call
 func (logger *Logger) Warn(args ...any) {
 	logger.entry().Warn(args...)
@@ -130,6 +276,21 @@ func (logger *Logger) Fatalf(format string, args ...any) {
 	logger.entry().Fatalf(format, args...)
 }
 
+// Panic forwards a panic logging call
+func (logger *Logger) Panic(args ...any) {
+	logger.entry().Panic(args...)
+}
+
+// Panicf forwards a panic logging call
+func (logger *Logger) Panicf(format string, args ...any) {
+	logger.entry().Panicf(format, args...)
+}
+
+// LogFields forwards a logging call with typed fields
+func (logger *Logger) LogFields(level Level, msg string, fields ...Field) {
+	logger.entry().LogFields(level, msg, fields...)
+}
+
 // Log forwards a logging call
 func (logger *Logger) Log(level Level, args ...any) {
 	logger.entry().Log(level, args...)
@@ -139,3 +300,43 @@ func (logger *Logger) Log(level Level, args ...any) {
 func (logger *Logger) Logf(level Level, format string, args ...any) {
 	logger.entry().Logf(level, format, args...)
 }
+
+// Infow forwards an info logging call with alternating keys and values
+func (logger *Logger) Infow(msg string, keysAndValues ...any) {
+	logger.entry().Infow(msg, keysAndValues...)
+}
+
+// Errorw forwards an error logging call with alternating keys and values
+func (logger *Logger) Errorw(msg string, keysAndValues ...any) {
+	logger.entry().Errorw(msg, keysAndValues...)
+}
+
+// Debugw forwards a debugging logging call with alternating keys and values
+func (logger *Logger) Debugw(msg string, keysAndValues ...any) {
+	logger.entry().Debugw(msg, keysAndValues...)
+}
+
+// Tracew forwards a tracing logging call with alternating keys and values
+func (logger *Logger) Tracew(msg string, keysAndValues ...any) {
+	logger.entry().Tracew(msg, keysAndValues...)
+}
+
+// Warnw forwards a warning logging call with alternating keys and values
+func (logger *Logger) Warnw(msg string, keysAndValues ...any) {
+	logger.entry().Warnw(msg, keysAndValues...)
+}
+
+// Fatalw forwards a fatal logging call with alternating keys and values
+func (logger *Logger) Fatalw(msg string, keysAndValues ...any) {
+	logger.entry().Fatalw(msg, keysAndValues...)
+}
+
+// Panicw forwards a panic logging call with alternating keys and values
+func (logger *Logger) Panicw(msg string, keysAndValues ...any) {
+	logger.entry().Panicw(msg, keysAndValues...)
+}
+
+// Logw forwards a logging call with alternating keys and values
+func (logger *Logger) Logw(level Level, msg string, keysAndValues ...any) {
+	logger.entry().Logw(level, msg, keysAndValues...)
+}
@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var benchmarkFields = Fields{
+	"order_id": "f0b2c7a4-3f7e-4a8c-9d38-2f4b1e6d9c01",
+	"amount":   129.5,
+	"attempt":  3,
+	"captured": true,
+	"elapsed":  250 * time.Millisecond,
+	"error":    errors.New("card declined"),
+}
+
+// newBenchmarkLogrusLogger sets up logrus the way New did before the native
+// encoder replaced it.
+func newBenchmarkLogrusLogger() *logrus.Logger {
+	logrusLogger := logrus.New()
+	logrusLogger.SetFormatter(&logrus.JSONFormatter{TimestampFormat: TimeFormatDefault})
+	logrusLogger.SetOutput(io.Discard)
+	logrusLogger.SetLevel(logrus.InfoLevel)
+	return logrusLogger
+}
+
+func BenchmarkLog(b *testing.B) {
+	ctx := context.Background()
+
+	b.Run("native", func(b *testing.B) {
+		logger := New(WithOutput(io.Discard), WithLevel(LevelInfo), WithReportCaller(false))
+		entry := logger.WithContext(ctx).WithFields(benchmarkFields)
+		b.ReportAllocs()
+		for b.Loop() {
+			entry.Info("payment captured")
+		}
+	})
+
+	b.Run("logrus", func(b *testing.B) {
+		logrusLogger := newBenchmarkLogrusLogger()
+		b.ReportAllocs()
+		for b.Loop() {
+			logrusLogger.WithContext(ctx).WithTime(time.Now()).WithFields(logrus.Fields(benchmarkFields)).Log(logrus.InfoLevel, "payment captured")
+		}
+	})
+}
+
+func BenchmarkLogFields(b *testing.B) {
+	ctx := context.Background()
+	err := errors.New("card declined")
+
+	b.Run("native", func(b *testing.B) {
+		logger := New(WithOutput(io.Discard), WithLevel(LevelInfo), WithReportCaller(false))
+		entry := logger.WithContext(ctx)
+		b.ReportAllocs()
+		for b.Loop() {
+			entry.LogFields(LevelInfo, "payment captured",
+				String("order_id", "f0b2c7a4-3f7e-4a8c-9d38-2f4b1e6d9c01"),
+				Float64("amount", 129.5),
+				Int("attempt", 3),
+				Bool("captured", true),
+				Duration("elapsed", 250*time.Millisecond),
+				Err(err),
+			)
+		}
+	})
+
+	b.Run("logrus", func(b *testing.B) {
+		logrusLogger := newBenchmarkLogrusLogger()
+		b.ReportAllocs()
+		for b.Loop() {
+			logrusLogger.WithContext(ctx).WithTime(time.Now()).WithFields(logrus.Fields{
+				"order_id": "f0b2c7a4-3f7e-4a8c-9d38-2f4b1e6d9c01",
+				"amount":   129.5,
+				"attempt":  3,
+				"captured": true,
+				"elapsed":  250 * time.Millisecond,
+				"error":    err,
+			}).Log(logrus.InfoLevel, "payment captured")
+		}
+	})
+}
+
+func BenchmarkLogWithCaller(b *testing.B) {
+	ctx := context.Background()
+
+	b.Run("native", func(b *testing.B) {
+		logger := New(WithOutput(io.Discard), WithLevel(LevelInfo))
+		entry := logger.WithContext(ctx).WithFields(benchmarkFields)
+		b.ReportAllocs()
+		for b.Loop() {
+			entry.Info("payment captured")
+		}
+	})
+
+	b.Run("logrus", func(b *testing.B) {
+		logrusLogger := newBenchmarkLogrusLogger()
+		b.ReportAllocs()
+		for b.Loop() {
+			fields := make(Fields, len(benchmarkFields)+2)
+			for k, v := range benchmarkFields {
+				fields[k] = v
+			}
+			addCallerFields(fields, getCaller(0))
+			logrusLogger.WithContext(ctx).WithTime(time.Now()).WithFields(logrus.Fields(fields)).Log(logrus.InfoLevel, "payment captured")
+		}
+	})
+}
+
+func BenchmarkLogDisabledLevel(b *testing.B) {
+	b.Run("native", func(b *testing.B) {
+		logger := New(WithOutput(io.Discard), WithLevel(LevelInfo))
+		entry := logger.WithFields(benchmarkFields)
+		b.ReportAllocs()
+		for b.Loop() {
+			entry.Debug("payment captured")
+		}
+	})
+
+	b.Run("logrus", func(b *testing.B) {
+		logrusLogger := newBenchmarkLogrusLogger()
+		b.ReportAllocs()
+		for b.Loop() {
+			logrusLogger.WithFields(logrus.Fields(benchmarkFields)).Log(logrus.DebugLevel, "payment captured")
+		}
+	})
+}
@@ -34,6 +34,12 @@ func ExampleWithLevelName() {
 	// Output: {"level":"info","msg":"now log level is set to info or lower, I will be logged","time":"2020-10-10T10:10:10.001Z"}
 }
 
+func ExampleWithFormat() {
+	logger := New(WithNowFunc(mockNowFunc), WithFormat(FormatConsole), WithReportCaller(false))
+	logger.Warnw("payment declined", "order_id", 42, "reason", "card expired")
+	// Output: WARN  10:10:10.001 payment declined                          order_id=42 reason="card expired"
+}
+
 func ExampleWithFields() {
 	// Example runner replaces os.Stdout to catch output and compares it with desired output.
 	// Global logger instance sets default output to os.Stdin before example runner has a chance to overwrite it.
@@ -65,6 +71,32 @@ func ExampleWithError() {
 	// Output: {"error":"Test error","level":"error","msg":"Operation failed","time":"2020-10-10T10:10:10.001Z"}
 }
 
+func ExampleLogger_With() {
+	logger := New(WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	payments := logger.With(String("component", "payments"))
+	payments.WithField("order_id", 42).Warn("payment declined")
+	// Output: {"component":"payments","level":"warning","msg":"payment declined","order_id":42,"time":"2020-10-10T10:10:10.001Z"}
+}
+
+func ExampleLogger_Warnw() {
+	logger := New(WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	logger.Warnw("payment declined", "order_id", 42, "amount", 99.5)
+	// Output: {"amount":99.5,"level":"warning","msg":"payment declined","order_id":42,"time":"2020-10-10T10:10:10.001Z"}
+}
+
+func ExampleLogger_LogFields() {
+	logger := New(WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	logger.LogFields(LevelWarn, "payment declined",
+		String("order_id", "abc"),
+		Duration("elapsed", 250*time.Millisecond),
+		Object("card", String("brand", "visa")),
+	)
+	// Output: {"card":{"brand":"visa"},"elapsed":250000000,"level":"warning","msg":"payment declined","order_id":"abc","time":"2020-10-10T10:10:10.001Z"}
+}
+
 type warner interface {
 	Warn(args ...any)
 }
@@ -103,8 +103,8 @@ func TestLogLevels(t *testing.T) {
 		expectedLogLevel string
 	}
 	buf := &bytes.Buffer{}
-	testLogger := New(WithOutput(buf), WithLevel(LevelDebug))
-	testLogger.logrusLogger.ExitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
+	testLogger := New(WithOutput(buf), WithLevel(LevelTrace))
+	testLogger.exitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
 	testCases := map[string]testCase{
 		"logger.Info() should log with level info": {
 			logFunc:          testLogger.Info,
@@ -118,6 +118,10 @@ func TestLogLevels(t *testing.T) {
 			logFunc:          testLogger.Debug,
 			expectedLogLevel: "debug",
 		},
+		"logger.Trace() should log with level trace": {
+			logFunc:          testLogger.Trace,
+			expectedLogLevel: "trace",
+		},
 		"logger.Warn() should log with level warning": {
 			logFunc:          testLogger.Warn,
 			expectedLogLevel: "warning",
@@ -141,8 +145,8 @@ func TestLogLevelsInFormatFuncs(t *testing.T) {
 		expectedLogLevel string
 	}
 	buf := &bytes.Buffer{}
-	testLogger := New(WithOutput(buf), WithLevel(LevelDebug))
-	testLogger.logrusLogger.ExitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
+	testLogger := New(WithOutput(buf), WithLevel(LevelTrace))
+	testLogger.exitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
 	testCases := map[string]testCase{
 		"logger.Infof() should log with level info": {
 			logFunc:          testLogger.Infof,
@@ -156,6 +160,10 @@ func TestLogLevelsInFormatFuncs(t *testing.T) {
 			logFunc:          testLogger.Debugf,
 			expectedLogLevel: "debug",
 		},
+		"logger.Tracef() should log with level trace": {
+			logFunc:          testLogger.Tracef,
+			expectedLogLevel: "trace",
+		},
 		"logger.Warnf() should log with level warning": {
 			logFunc:          testLogger.Warnf,
 			expectedLogLevel: "warning",
@@ -179,8 +187,8 @@ func TestLogLevelsInLogFunc(t *testing.T) {
 		expectedLogLevel string
 	}
 	buf := &bytes.Buffer{}
-	testLogger := New(WithOutput(buf), WithLevel(LevelDebug))
-	testLogger.logrusLogger.ExitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
+	testLogger := New(WithOutput(buf), WithLevel(LevelTrace))
+	testLogger.exitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
 	testCases := map[string]testCase{
 		"logger.Infof() should log with level info": {
 			logLevel:         LevelInfo,
@@ -194,6 +202,10 @@ func TestLogLevelsInLogFunc(t *testing.T) {
 			logLevel:         LevelDebug,
 			expectedLogLevel: "debug",
 		},
+		"logger.Tracef() should log with level trace": {
+			logLevel:         LevelTrace,
+			expectedLogLevel: "trace",
+		},
 		"logger.Warnf() should log with level warning": {
 			logLevel:         LevelWarn,
 			expectedLogLevel: "warning",
@@ -217,8 +229,8 @@ func TestLogLevelsInLogfFunc(t *testing.T) {
 		expectedLogLevel string
 	}
 	buf := &bytes.Buffer{}
-	testLogger := New(WithOutput(buf), WithLevel(LevelDebug))
-	testLogger.logrusLogger.ExitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
+	testLogger := New(WithOutput(buf), WithLevel(LevelTrace))
+	testLogger.exitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
 	testCases := map[string]testCase{
 		"logger.Infof() should log with level info": {
 			logLevel:         LevelInfo,
@@ -232,6 +244,10 @@ func TestLogLevelsInLogfFunc(t *testing.T) {
 			logLevel:         LevelDebug,
 			expectedLogLevel: "debug",
 		},
+		"logger.Tracef() should log with level trace": {
+			logLevel:         LevelTrace,
+			expectedLogLevel: "trace",
+		},
 		"logger.Warnf() should log with level warning": {
 			logLevel:         LevelWarn,
 			expectedLogLevel: "warning",
@@ -322,6 +338,10 @@ func TestSettingLogLevel(t *testing.T) {
 	}
 
 	testCases := map[string]testCase{
+		"log nothing": {
+			logLevel:             LevelOff,
+			expectedLoggedLevels: []Level{},
+		},
 		"log fatal and above": {
 			logLevel:             LevelFatal,
 			expectedLoggedLevels: []Level{LevelFatal},
@@ -342,12 +362,21 @@ func TestSettingLogLevel(t *testing.T) {
 			logLevel:             LevelDebug,
 			expectedLoggedLevels: []Level{LevelDebug, LevelInfo, LevelWarn, LevelError, LevelFatal},
 		},
+		"log trace and above": {
+			logLevel:             LevelTrace,
+			expectedLoggedLevels: []Level{LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelFatal},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			buf := &bytes.Buffer{}
 			logger := New(WithOutput(buf), WithLevel(tc.logLevel))
-			logger.logrusLogger.ExitFunc = func(int) {}
+			logger.exitFunc = func(int) {}
+
+			logger.Trace("trace")
+			if contains(tc.expectedLoggedLevels, LevelTrace) != wasLogged(t, buf) {
+				t.Fatalf("trace level was incorrectly filtered")
+			}
 
 			logger.Debug("debug")
 			if contains(tc.expectedLoggedLevels, LevelDebug) != wasLogged(t, buf) {
@@ -380,8 +409,8 @@ func TestSettingLogLevel(t *testing.T) {
 func TestWithLevelName(t *testing.T) {
 	for name, lvl := range nameMapping {
 		logger := New(WithLevelName(name))
-		if logger.level != lvl {
-			t.Fatalf("expected level %v, got %v", lvl, logger.logrusLogger.Level)
+		if logger.Level() != lvl {
+			t.Fatalf("expected level %v, got %v", lvl, logger.Level())
 		}
 	}
 }
@@ -393,6 +422,31 @@ func TestBadLevelName(t *testing.T) {
 	}
 }
 
+func TestLevelOff(t *testing.T) {
+	buf := &bytes.Buffer{}
+	hookCalls := 0
+	logger := New(WithOutput(buf), WithLevelName("off"), WithHookFunc(func(*HookEntry) (bool, error) {
+		hookCalls++
+		return false, nil
+	}))
+	logger.exitFunc = func(int) {}
+
+	logger.Fatal("fatal")
+	logger.WithContext(context.Background()).Error("error")
+	assert.False(t, wasLogged(t, buf))
+	assert.Equal(t, 0, hookCalls)
+	assert.Equal(t, "off", logger.Level().String())
+}
+
+func TestLogAtLevelOff(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelTrace))
+
+	logger.Log(LevelOff, "foobar")
+	logger.WithContext(ContextWithLevel(context.Background(), LevelOff)).Log(LevelOff, "foobar")
+	assert.False(t, wasLogged(t, buf))
+}
+
 func TestReportingCaller(t *testing.T) {
 	builder := &strings.Builder{}
 	logger := New(WithOutput(builder), WithLevel(LevelInfo))
@@ -457,12 +511,11 @@ func unique[T comparable](slice []T) []T {
 }
 
 func TestDisableReportingCaller(t *testing.T) {
-	buf := &bytes.Buffer{}
-	tee := io.TeeReader(buf, buf)
-	logger := New(WithOutput(buf), WithReportCaller(false))
+	builder := &strings.Builder{}
+	logger := New(WithOutput(builder), WithReportCaller(false))
 	logger.Error("foobar")
-	assertLogEntryDoesNotHaveKey(t, tee, "file")
-	assertLogEntryDoesNotHaveKey(t, tee, "function")
+	assertLogEntryDoesNotHaveKey(t, strings.NewReader(builder.String()), "file")
+	assertLogEntryDoesNotHaveKey(t, strings.NewReader(builder.String()), "function")
 }
 
 type myCtxKey struct{}
@@ -661,7 +714,7 @@ func TestOnlyFatalExits(t *testing.T) {
 		expectedLogLevel string
 	}
 	buf := &bytes.Buffer{}
-	testLogger := New(WithOutput(buf), WithLevel(LevelDebug))
+	testLogger := New(WithOutput(buf), WithLevel(LevelTrace))
 	testCases := map[string]testCase{
 		"level info": {
 			logFunc:          testLogger.Info,
@@ -681,6 +734,12 @@ func TestOnlyFatalExits(t *testing.T) {
 			logLevel:         LevelDebug,
 			expectedLogLevel: "debug",
 		},
+		"level trace": {
+			logFunc:          testLogger.Trace,
+			logfFunc:         testLogger.Tracef,
+			logLevel:         LevelTrace,
+			expectedLogLevel: "trace",
+		},
 		"level warning": {
 			logFunc:          testLogger.Warn,
 			logfFunc:         testLogger.Warnf,
@@ -698,7 +757,7 @@ func TestOnlyFatalExits(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			exitCount := 0
 			// prevent .Fatal() from shutting down test runner, and also count times it happened
-			testLogger.logrusLogger.ExitFunc = func(int) {
+			testLogger.exitFunc = func(int) {
 				exitCount++
 			}
 
@@ -725,3 +784,245 @@ func TestOnlyFatalExits(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyValueLogging(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	testLogger := New(WithOutput(buf), WithLevel(LevelTrace))
+	testLogger.exitFunc = func(int) {} // prevent .Fatalw() from shutting down test runner
+	testCases := map[string]struct {
+		logFunc          func(msg string, keysAndValues ...any)
+		expectedLogLevel string
+	}{
+		"Infow":  {logFunc: testLogger.Infow, expectedLogLevel: "info"},
+		"Errorw": {logFunc: testLogger.Errorw, expectedLogLevel: "error"},
+		"Debugw": {logFunc: testLogger.Debugw, expectedLogLevel: "debug"},
+		"Tracew": {logFunc: testLogger.Tracew, expectedLogLevel: "trace"},
+		"Warnw":  {logFunc: testLogger.Warnw, expectedLogLevel: "warning"},
+		"Fatalw": {logFunc: testLogger.Fatalw, expectedLogLevel: "fatal"},
+		"Entry.Infow": {
+			logFunc:          testLogger.WithField("foo", "bar").Infow,
+			expectedLogLevel: "info",
+		},
+		"Logw": {
+			logFunc: func(msg string, keysAndValues ...any) {
+				testLogger.Logw(LevelInfo, msg, keysAndValues...)
+			},
+			expectedLogLevel: "info",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.logFunc("payment captured", "order_id", 42, "amount")
+
+			log := decodeLogToMap(t, buf)
+			assert.Equal(t, tc.expectedLogLevel, log["level"])
+			assert.Equal(t, "payment captured", log["msg"])
+			assert.Equal(t, float64(42), log["order_id"])
+			assert.Equal(t, "amount", log["!BADKEY"])
+			assert.Regexp(t, regexp.MustCompile(`logger_test\.go:\d+$`), log["file"])
+		})
+	}
+}
+
+func TestPanicw(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf))
+
+	assert.PanicsWithValue(t, "foobar", func() { logger.Panicw("foobar", "foo", "bar") })
+	assertLogEntryContains(t, bytes.NewReader(buf.Bytes()), "level", "panic")
+	assertLogEntryContains(t, buf, "foo", "bar")
+}
+
+func TestPanic(t *testing.T) {
+	type testCase struct {
+		logFunc func(l *Logger)
+	}
+	testCases := map[string]testCase{
+		"Panic":  {logFunc: func(l *Logger) { l.Panic("foo", "bar") }},
+		"Panicf": {logFunc: func(l *Logger) { l.Panicf("foo%s", "bar") }},
+		"Log":    {logFunc: func(l *Logger) { l.Log(LevelPanic, "foo", "bar") }},
+		"Logf":   {logFunc: func(l *Logger) { l.Logf(LevelPanic, "foo%s", "bar") }},
+		"Entry":  {logFunc: func(l *Logger) { l.WithField("a", 1).Panic("foobar") }},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			hookCalls := 0
+			logger := New(WithOutput(buf), WithHookFunc(func(*HookEntry) (bool, error) {
+				hookCalls++
+				return false, nil
+			}))
+			logger.exitFunc = func(int) { t.Fatal("panic must not exit") }
+
+			assert.PanicsWithValue(t, "foobar", func() { tc.logFunc(logger) })
+			assertLogEntryContains(t, buf, "level", "panic")
+			assert.Equal(t, 1, hookCalls)
+		})
+	}
+}
+
+func TestPanicLevelFiltering(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelFatal))
+	assert.Panics(t, func() { logger.Panic("foobar") })
+	assert.True(t, wasLogged(t, buf))
+
+	logger.SetLevel(LevelPanic)
+	logger.exitFunc = func(int) {}
+	logger.Fatal("foobar")
+	assert.False(t, wasLogged(t, buf))
+
+	logger.SetLevel(LevelOff)
+	assert.Panics(t, func() { logger.Panic("foobar") })
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestChildLoggerFields(t *testing.T) {
+	builder := &strings.Builder{}
+	logger := New(WithOutput(builder), WithLevel(LevelInfo))
+	child := logger.With(String("component", "payments"), String("foo", "bar"))
+	grandchild := child.With(String("foo", "overridden"))
+
+	child.Info("child")
+	grandchild.WithField("entry", true).Info("grandchild")
+	child.WithField("component", "entry-wins").Info("entry")
+	logger.Info("parent")
+
+	lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+	require.Len(t, lines, 4)
+
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "component", "payments")
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "foo", "bar")
+
+	assertLogEntryContains(t, strings.NewReader(lines[1]), "component", "payments")
+	assertLogEntryContains(t, strings.NewReader(lines[1]), "foo", "overridden")
+	assertLogEntryContains(t, strings.NewReader(lines[1]), "entry", true)
+
+	assertLogEntryContains(t, strings.NewReader(lines[2]), "component", "entry-wins")
+
+	assertLogEntryDoesNotHaveKey(t, strings.NewReader(lines[3]), "component")
+	assertLogEntryDoesNotHaveKey(t, strings.NewReader(lines[3]), "foo")
+}
+
+func TestChildLoggerKeepsTypedFields(t *testing.T) {
+	logger := New(WithOutput(io.Discard))
+	fields := []Field{Int64("amount", 42), Duration("elapsed", time.Second)}
+	child := logger.With(fields...)
+	fields[0] = String("amount", "changed")
+
+	assert.Equal(t, []Field{Int64("amount", 42), Duration("elapsed", time.Second)}, child.fields)
+	assert.Equal(t, []Field{Int64("amount", 42), Duration("elapsed", time.Second), String("foo", "bar")}, child.With(String("foo", "bar")).fields)
+	assert.Len(t, child.fields, 2)
+}
+
+func TestChildLoggerSharesOutputAndHooks(t *testing.T) {
+	ctx := context.WithValue(context.Background(), myCtxKey{}, "my-custom-ctx-value")
+
+	logger := New(WithLevel(LevelInfo))
+	child := logger.With(String("component", "payments"))
+
+	// Configuring the parent after the child was created also affects the child
+	buf := &bytes.Buffer{}
+	logger.applyOptions(WithOutput(buf), WithHookFunc(testHook))
+
+	child.WithContext(ctx).Info("foobar")
+	b := buf.Bytes()
+	assertLogEntryContains(t, bytes.NewReader(b), "component", "payments")
+	assertLogEntryContains(t, bytes.NewReader(b), "my-custom-log-key", "my-custom-ctx-value")
+}
+
+func TestChildLoggerLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelWarn))
+	inheriting := logger.With(String("component", "payments"))
+	debugChild := inheriting.WithMinLevel(LevelDebug)
+	inheritingDebug := debugChild.With(Bool("sub", true))
+
+	inheriting.Info("filtered")
+	assert.False(t, wasLogged(t, buf))
+
+	debugChild.Debug("logged")
+	assertLogEntryContains(t, buf, "component", "payments")
+
+	inheritingDebug.Debug("logged")
+	assertLogEntryContains(t, buf, "sub", true)
+
+	logger.applyOptions(WithLevel(LevelInfo))
+	inheriting.Info("follows parent")
+	assert.True(t, wasLogged(t, buf))
+
+	logger.Debug("filtered")
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestNamedLogger(t *testing.T) {
+	builder := &strings.Builder{}
+	logger := New(WithOutput(builder), WithLevel(LevelInfo))
+
+	billing := logger.Named("billing")
+	billing.Info("billing")
+	billing.Named("invoice").Info("invoice")
+	billing.With(String("foo", "bar")).Named("").Named("invoice").Info("child of child")
+	logger.Named("").Info("unnamed")
+
+	lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+	require.Len(t, lines, 4)
+	assertLogEntryContains(t, strings.NewReader(lines[0]), "logger", "billing")
+	assertLogEntryContains(t, strings.NewReader(lines[1]), "logger", "billing.invoice")
+	assertLogEntryContains(t, strings.NewReader(lines[2]), "logger", "billing.invoice")
+	assertLogEntryContains(t, strings.NewReader(lines[2]), "foo", "bar")
+	assertLogEntryDoesNotHaveKey(t, strings.NewReader(lines[3]), "logger")
+}
+
+func TestLevelOverrides(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelWarn), WithLevelOverrides(map[string]Level{
+		"billing":         LevelDebug,
+		"billing.invoice": LevelError,
+	}))
+
+	testCases := map[string]struct {
+		logger         *Logger
+		expectedLevels []Level
+	}{
+		"root is not affected": {
+			logger:         logger,
+			expectedLevels: []Level{LevelWarn, LevelError, LevelFatal},
+		},
+		"other names follow the root": {
+			logger:         logger.Named("shipping"),
+			expectedLevels: []Level{LevelWarn, LevelError, LevelFatal},
+		},
+		"override by exact name": {
+			logger:         logger.Named("billing"),
+			expectedLevels: []Level{LevelDebug, LevelInfo, LevelWarn, LevelError, LevelFatal},
+		},
+		"override of parent name": {
+			logger:         logger.Named("billing").Named("payment"),
+			expectedLevels: []Level{LevelDebug, LevelInfo, LevelWarn, LevelError, LevelFatal},
+		},
+		"override of parent name with fields": {
+			logger:         logger.Named("billing").With(String("foo", "bar")),
+			expectedLevels: []Level{LevelDebug, LevelInfo, LevelWarn, LevelError, LevelFatal},
+		},
+		"closest override wins": {
+			logger:         logger.Named("billing").Named("invoice").Named("pdf"),
+			expectedLevels: []Level{LevelError, LevelFatal},
+		},
+		"own level wins over override": {
+			logger:         logger.Named("billing").WithMinLevel(LevelInfo),
+			expectedLevels: []Level{LevelInfo, LevelWarn, LevelError, LevelFatal},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, level := range []Level{LevelDebug, LevelInfo, LevelWarn, LevelError} {
+				tc.logger.Log(level, "foobar")
+				if contains(tc.expectedLevels, level) != wasLogged(t, buf) {
+					t.Fatalf("%v level was incorrectly filtered", level.outputName())
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package logger
 
 import (
 	"io"
+	"log/slog"
+	"maps"
 	"os"
 )
 
@@ -33,10 +35,86 @@ func WithOutput(output io.Writer) LoggerOption {
 	})
 }
 
+// WithFormat sets the layout entries are written to the output in. When no
+// format is set, FormatConsole is used if the output is a terminal and
+// FormatJSON otherwise. WithFormat and WithSlogHandler replace each other,
+// so of the two the option applied last is used, also when the other was set
+// earlier with ConfigureGlobalLogger.
+func WithFormat(format Format) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.format = format
+		l.formatSet = true
+		if _, ok := l.backend.(*encoderBackend); !ok {
+			l.backend = &encoderBackend{core: l.core}
+		}
+	})
+}
+
+// WithKeys renames the keys of the fields written by the logger, e.g. to
+// write the message as "message" and the level as "status". Keys that are
+// left empty keep their default. Keys with dots, like "logger.file", are
+// nested. The keys apply to every Format, but not to the fields passed to
+// hooks or to a slog.Handler set with WithSlogHandler.
+//
+//	logger.New(logger.WithKeys(logger.Keys{Message: "message", Level: "status"}))
+func WithKeys(keys Keys) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.keys = keys
+	})
+}
+
+// WithService adds the name, environment and version of the service to every
+// entry, as the fields service, env and version. Empty values are left out.
+func WithService(service, env, version string) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.service, l.env, l.version = service, env, version
+	})
+}
+
+// WithTraceContextFunc adds the trace_id and span_id returned by fn for the
+// context of an entry, set with WithContext, to correlate entries with traces.
+// For example with Datadog tracing:
+//
+//	logger.WithTraceContextFunc(func(ctx context.Context) (string, string, bool) {
+//		span, ok := tracer.SpanFromContext(ctx)
+//		if !ok {
+//			return "", "", false
+//		}
+//		return strconv.FormatUint(span.Context().TraceID(), 10), strconv.FormatUint(span.Context().SpanID(), 10), true
+//	})
+func WithTraceContextFunc(fn TraceContextFunc) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.traceContext = fn
+	})
+}
+
+// WithTimeFormat sets the format of the time of entries: TimeFormatDefault,
+// TimeFormatRFC3339Nano, TimeFormatUnixMilli or any layout accepted by
+// time.Format. FormatConsole only writes the time of day, unless a time format
+// is set.
+func WithTimeFormat(format string) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.timeFormat = format
+	})
+}
+
+// WithExitFunc overrides the function called with exit code 1 after an entry
+// is logged at LevelFatal. The default is os.Exit. Exit handlers registered
+// with RegisterExitHandler are called before it. See PanicOnExit for use in
+// tests.
+func WithExitFunc(exitFunc func(code int)) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		if exitFunc == nil {
+			return
+		}
+		l.exitFunc = exitFunc
+	})
+}
+
 // WithLevel sets minimum level for filtering logs
 func WithLevel(level Level) LoggerOption {
 	return LoggerOptionFunc(func(l *Logger) {
-		l.level = level
+		l.level.set(level)
 	})
 }
 
@@ -48,7 +126,19 @@ func WithLevelName(level string) LoggerOption {
 			lvl = LevelWarn
 			l.Warn("Invalid log level, defaulting to Warn")
 		}
-		l.level = lvl
+		l.level.set(lvl)
+	})
+}
+
+// WithLevelOverrides sets minimum levels for named loggers, see Logger.Named.
+// An override applies to the logger with that name and to all loggers nested
+// below it, unless they have an override of their own. Loggers given their
+// own level with Logger.WithMinLevel are not affected.
+//
+//	logger.WithLevelOverrides(map[string]logger.Level{"billing": logger.LevelDebug})
+func WithLevelOverrides(overrides map[string]Level) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.levelOverrides = maps.Clone(overrides)
 	})
 }
 
@@ -59,6 +149,46 @@ func WithReportCaller(enable bool) LoggerOption {
 	})
 }
 
+// WithCallerSkip skips n more frames when looking up the caller of an entry,
+// for loggers that are only used through a wrapper function, like zap's
+// AddCallerSkip. To skip all functions of a wrapper package use
+// RegisterHelperPackage instead. Entries logged through slog report the
+// caller given by slog.
+func WithCallerSkip(n int) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.callerSkip = n
+	})
+}
+
+// WithErrorFields expands the error added with WithError or Err into an
+// object with the fields error.message, error.kind (the Go type of the error),
+// error.chain (the errors it wraps, following errors.Unwrap and errors.Join)
+// and error.stack (when the error carries a stack trace), as used by Datadog
+// error tracking. Hooks still get the error itself.
+func WithErrorFields(enable bool) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.errorFields = enable
+	})
+}
+
+// WithStacktraceLevel adds a stack trace of the goroutine to entries at or
+// above level, e.g. WithStacktraceLevel(LevelError). The stack trace starts at
+// the function that logged the entry. Use LevelOff, the default, to add no
+// stack traces.
+func WithStacktraceLevel(level Level) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.stacktraceLevel = level
+	})
+}
+
+// WithStacktraceFormat sets how stack traces added with WithStacktraceLevel
+// are written, as a single string (the default) or as an array of frames.
+func WithStacktraceFormat(format StacktraceFormat) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		l.stacktraceFormat = format
+	})
+}
+
 // WithHookFunc allows for connecting a hook to the logger, which will be triggered on all log-entries.
 func WithHookFunc(hook HookFunc) LoggerOption {
 	if hook == nil {
@@ -73,7 +203,22 @@ func WithHook(hook Hook) LoggerOption {
 		if hook == nil {
 			return
 		}
-		l.logrusLogger.Hooks.Add(&logrusHook{hook: hook})
+		l.hooks = append(l.hooks, hook)
+	})
+}
+
+// WithSlogHandler makes the logger write all entries through handler instead of
+// writing JSON to the output. Entries are filtered by the level of the logger
+// before being passed to the handler, and hooks fire before the handler is
+// called. Fields are passed as attributes, with nested Fields as groups.
+// WithSlogHandler and WithFormat replace each other, so of the two the option
+// applied last is used.
+func WithSlogHandler(handler slog.Handler) LoggerOption {
+	return LoggerOptionFunc(func(l *Logger) {
+		if handler == nil {
+			return
+		}
+		l.backend = &slogBackend{handler: handler}
 	})
 }
 
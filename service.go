@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"context"
+)
+
+// Keys of the service and trace fields, see WithService and
+// WithTraceContextFunc.
+const (
+	serviceKey = "service"
+	envKey     = "env"
+	versionKey = "version"
+	traceIDKey = "trace_id"
+	spanIDKey  = "span_id"
+)
+
+// TraceContextFunc returns the ids of the trace and span in ctx, used to
+// correlate entries with traces. ok is false when ctx holds no span.
+type TraceContextFunc func(ctx context.Context) (traceID, spanID string, ok bool)
+
+// appendServiceFields appends the service fields set with WithService, and
+// the trace fields of ctx when a TraceContextFunc is set, to typed.
+func (c *core) appendServiceFields(typed []Field, ctx context.Context) []Field {
+	var fields []Field
+	for _, f := range [...]Field{
+		builtinField(serviceKey, c.service),
+		builtinField(envKey, c.env),
+		builtinField(versionKey, c.version),
+	} {
+		if f.str != "" {
+			fields = append(fields, f)
+		}
+	}
+	if c.traceContext != nil && ctx != nil {
+		if traceID, spanID, ok := c.traceContext(ctx); ok {
+			fields = append(fields, builtinField(traceIDKey, c.traceIDPrefix+traceID), builtinField(spanIDKey, spanID))
+		}
+	}
+	return appendTyped(typed, fields)
+}
+
+// builtinField returns a field added by go-logger itself, see builtinKind.
+func builtinField(key, value string) Field {
+	return Field{key: key, kind: builtinKind, str: value}
+}
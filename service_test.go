@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type spanContextKey struct{}
+
+func testTraceContext(ctx context.Context) (string, string, bool) {
+	ids, ok := ctx.Value(spanContextKey{}).([2]string)
+	return ids[0], ids[1], ok
+}
+
+func TestWithService(t *testing.T) {
+	testCases := map[string]struct {
+		service, env, version string
+		expected              string
+	}{
+		"all": {
+			service:  "payments",
+			env:      "production",
+			version:  "1.2.3",
+			expected: `{"env":"production","level":"warning","msg":"foobar","service":"payments","time":"2020-10-10T10:10:10.001Z","version":"1.2.3"}`,
+		},
+		"service only": {
+			service:  "payments",
+			expected: `{"level":"warning","msg":"foobar","service":"payments","time":"2020-10-10T10:10:10.001Z"}`,
+		},
+		"none": {
+			expected: `{"level":"warning","msg":"foobar","time":"2020-10-10T10:10:10.001Z"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithService(tc.service, tc.env, tc.version))
+
+			logger.Warn("foobar")
+			assert.Equal(t, tc.expected+"\n", buf.String())
+		})
+	}
+}
+
+func TestWithTraceContextFunc(t *testing.T) {
+	traced := context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})
+
+	testCases := map[string]struct {
+		log      func(logger *Logger)
+		expected string
+	}{
+		"traced context": {
+			log:      func(logger *Logger) { logger.WithContext(traced).Warn("foobar") },
+			expected: `{"level":"warning","msg":"foobar","span_id":"456","time":"2020-10-10T10:10:10.001Z","trace_id":"123"}`,
+		},
+		"context without span": {
+			log:      func(logger *Logger) { logger.WithContext(t.Context()).Warn("foobar") },
+			expected: `{"level":"warning","msg":"foobar","time":"2020-10-10T10:10:10.001Z"}`,
+		},
+		"no context": {
+			log:      func(logger *Logger) { logger.Warn("foobar") },
+			expected: `{"level":"warning","msg":"foobar","time":"2020-10-10T10:10:10.001Z"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			tc.log(New(WithOutput(buf), WithNowFunc(mockNowFunc), WithReportCaller(false), WithTraceContextFunc(testTraceContext)))
+			assert.Equal(t, tc.expected+"\n", buf.String())
+		})
+	}
+}
+
+func TestTraceContextHooks(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var data Fields
+	logger := New(WithOutput(buf), WithTraceContextFunc(testTraceContext), WithHookFunc(func(e *HookEntry) (bool, error) {
+		data = e.Data
+		return false, nil
+	}))
+
+	logger.WithContext(context.WithValue(t.Context(), spanContextKey{}, [2]string{"123", "456"})).Warn("foobar")
+	assert.Equal(t, "123", data["trace_id"])
+	assert.Equal(t, "456", data["span_id"])
+}
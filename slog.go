@@ -0,0 +1,134 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"runtime"
+)
+
+// Ensure SlogHandler implements the slog.Handler interface.
+var _ slog.Handler = (*SlogHandler)(nil)
+
+// SlogHandler is a slog.Handler that routes records through a Logger, so
+// libraries that only speak log/slog emit the same JSON shape, caller fields
+// and hooks as the rest of the service. Do not create this directly, use
+// NewSlogHandler() or Logger.Slog().
+type SlogHandler struct {
+	entry  *Entry
+	groups []string
+}
+
+// NewSlogHandler creates a slog.Handler that emits records through logger.
+func NewSlogHandler(logger *Logger) *SlogHandler {
+	return &SlogHandler{entry: logger.entry()}
+}
+
+// Slog returns a *slog.Logger that emits records through the logger.
+func (logger *Logger) Slog() *slog.Logger {
+	return slog.New(NewSlogHandler(logger))
+}
+
+// Enabled reports whether the logger emits records at the given slog level,
+// taking a level set on ctx with ContextWithLevel into account.
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.entry.WithContext(ctx).enabled(mapSlogLevelToLevel(level))
+}
+
+// Handle emits the record through the logger. Attributes of the record are
+// added as fields, nested under the groups opened with WithGroup.
+func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	level := mapSlogLevelToLevel(r.Level)
+	if !h.Enabled(ctx, r.Level) {
+		return nil
+	}
+
+	attrs := make(Fields, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		addSlogAttr(attrs, a)
+		return true
+	})
+
+	e := &Entry{logger: h.entry.logger, fields: h.entry.fields, typed: h.entry.typed, context: ctx}
+	if len(attrs) > 0 {
+		e.fields = mergeGroupFields(e.fields, h.groups, attrs)
+	}
+
+	var frame *runtime.Frame
+	if e.logger.reportCaller && r.PC != 0 {
+		f, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+		frame = &f
+	}
+	e.write(level, frame, r.Message)
+	return nil
+}
+
+// WithAttrs returns a handler whose records always include attrs.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make(Fields, len(attrs))
+	for _, a := range attrs {
+		addSlogAttr(fields, a)
+	}
+	if len(fields) == 0 {
+		return h
+	}
+	e := h.entry
+	return &SlogHandler{
+		entry:  &Entry{logger: e.logger, fields: mergeGroupFields(e.fields, h.groups, fields), typed: e.typed, context: e.context},
+		groups: h.groups,
+	}
+}
+
+// WithGroup returns a handler that nests all following attributes under name.
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &SlogHandler{
+		entry:  h.entry,
+		groups: append(h.groups[:len(h.groups):len(h.groups)], name),
+	}
+}
+
+// addSlogAttr resolves a and adds it to fields, following the slog.Handler
+// rules for empty attributes and groups.
+func addSlogAttr(fields Fields, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() != slog.KindGroup {
+		fields[a.Key] = a.Value.Any()
+		return
+	}
+
+	group := a.Value.Group()
+	if len(group) == 0 {
+		return
+	}
+	// Attributes of a group with an empty key are inlined in the parent.
+	target := fields
+	if a.Key != "" {
+		target = make(Fields, len(group))
+	}
+	for _, ga := range group {
+		addSlogAttr(target, ga)
+	}
+	if a.Key != "" && len(target) > 0 {
+		fields[a.Key] = target
+	}
+}
+
+// mergeGroupFields returns a copy of base with fields added under the nested
+// groups. base is not mutated.
+func mergeGroupFields(base Fields, groups []string, fields Fields) Fields {
+	merged := make(Fields, len(base)+len(fields))
+	maps.Copy(merged, base)
+	if len(groups) == 0 {
+		maps.Copy(merged, fields)
+		return merged
+	}
+	group, _ := base[groups[0]].(Fields)
+	merged[groups[0]] = mergeGroupFields(group, groups[1:], fields)
+	return merged
+}
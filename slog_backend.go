@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+)
+
+// slogBackend writes records through a slog.Handler.
+type slogBackend struct {
+	handler slog.Handler
+}
+
+func (b *slogBackend) write(r *record) {
+	ctx := r.context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	level := mapLevelToSlogLevel(r.level)
+	if !b.handler.Enabled(ctx, level) {
+		return
+	}
+	r.materialize()
+	r.addBuiltinFields()
+	sr := slog.NewRecord(r.time, level, r.message, r.pc)
+	sr.AddAttrs(fieldsToSlogAttrs(r.fields)...)
+	if err := b.handler.Handle(ctx, sr); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write to log, %v\n", err)
+	}
+}
+
+// fieldsToSlogAttrs converts fields to attributes sorted by key. Nested Fields
+// are converted to groups.
+func fieldsToSlogAttrs(fields Fields) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		if group, ok := fields[key].(Fields); ok {
+			attrs = append(attrs, slog.Attr{Key: key, Value: slog.GroupValue(fieldsToSlogAttrs(group)...)})
+			continue
+		}
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+	return attrs
+}
@@ -0,0 +1,116 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogBackendLevels(t *testing.T) {
+	testCases := map[string]struct {
+		level         Level
+		expectedLevel string
+	}{
+		"LevelTrace maps to DEBUG-": {level: LevelTrace, expectedLevel: "DEBUG-4"},
+		"LevelDebug maps to DEBUG":  {level: LevelDebug, expectedLevel: "DEBUG"},
+		"LevelInfo maps to INFO":    {level: LevelInfo, expectedLevel: "INFO"},
+		"LevelWarn maps to WARN":    {level: LevelWarn, expectedLevel: "WARN"},
+		"LevelError maps to ERROR":  {level: LevelError, expectedLevel: "ERROR"},
+		"LevelFatal maps to ERROR+": {level: LevelFatal, expectedLevel: "ERROR+4"},
+		"LevelPanic maps to ERROR+": {level: LevelPanic, expectedLevel: "ERROR+8"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug - 4})
+			logger := New(WithSlogHandler(handler), WithLevel(LevelTrace))
+			logger.exitFunc = func(int) {} // prevent .Fatal() from shutting down test runner
+
+			func() {
+				defer func() { _ = recover() }() // LevelPanic panics after logging
+				logger.Log(tc.level, "foobar")
+			}()
+			assertLogEntryContains(t, buf, "level", tc.expectedLevel)
+		})
+	}
+}
+
+func TestSlogBackendFiltersOnLoggerLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	logger := New(WithSlogHandler(handler), WithLevel(LevelWarn))
+
+	logger.Info("filtered by logger")
+	assert.False(t, wasLogged(t, buf))
+
+	logger.Warn("logged")
+	assert.True(t, wasLogged(t, buf))
+}
+
+func TestSlogBackendRespectsHandlerLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelError})
+	logger := New(WithSlogHandler(handler), WithLevel(LevelDebug))
+
+	logger.Warn("filtered by handler")
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestSlogBackendFieldsAndCaller(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{AddSource: true})
+	logger := New(WithSlogHandler(handler), WithLevel(LevelInfo), WithNowFunc(mockNowFunc))
+
+	logger.WithFields(Fields{
+		"foo":   "bar",
+		"group": Fields{"nested": 1},
+	}).WithError(errors.New("some error")).Info("hello")
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, "hello", log["msg"])
+	assert.Equal(t, "2020-10-10T10:10:10.001Z", log["time"])
+	assert.Equal(t, "bar", log["foo"])
+	assert.Equal(t, "some error", log["error"])
+	assert.Equal(t, map[string]any{"nested": float64(1)}, log["group"])
+	assert.Equal(t, "github.com/coopnorge/go-logger.TestSlogBackendFieldsAndCaller", log["function"])
+	assert.Regexp(t, `slog_backend_test\.go:\d+$`, log["file"])
+
+	source, ok := log["source"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "github.com/coopnorge/go-logger.TestSlogBackendFieldsAndCaller", source["function"])
+	assert.True(t, strings.HasSuffix(source["file"].(string), "slog_backend_test.go"))
+}
+
+func TestSlogBackendFiresHooks(t *testing.T) {
+	ctx := context.WithValue(context.Background(), myCtxKey{}, "my-custom-ctx-value")
+
+	buf := &bytes.Buffer{}
+	logger := New(WithSlogHandler(slog.NewJSONHandler(buf, nil)), WithHookFunc(testHook))
+	logger.WithContext(ctx).Error("foobar")
+
+	assertLogEntryContains(t, buf, "my-custom-log-key", "my-custom-ctx-value")
+}
+
+func TestSlogFrontendWithSlogBackend(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithSlogHandler(slog.NewJSONHandler(buf, nil)), WithLevel(LevelInfo), WithReportCaller(false))
+
+	logger.Slog().WithGroup("req").Info("hello", "id", 42)
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, map[string]any{"id": float64(42)}, log["req"])
+}
+
+func TestNilSlogHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithSlogHandler(nil))
+	logger.Error("foobar")
+	assertLogEntryContains(t, buf, "level", "error")
+}
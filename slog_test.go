@@ -0,0 +1,116 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogLevels(t *testing.T) {
+	testCases := map[string]struct {
+		level            slog.Level
+		expectedLogLevel string
+	}{
+		"slog debug maps to debug":     {level: slog.LevelDebug, expectedLogLevel: "debug"},
+		"slog info maps to info":       {level: slog.LevelInfo, expectedLogLevel: "info"},
+		"slog warn maps to warning":    {level: slog.LevelWarn, expectedLogLevel: "warning"},
+		"slog error maps to error":     {level: slog.LevelError, expectedLogLevel: "error"},
+		"above error maps to error":    {level: slog.LevelError + 4, expectedLogLevel: "error"},
+		"between levels rounds down":   {level: slog.LevelInfo + 2, expectedLogLevel: "info"},
+		"below debug maps to trace":    {level: slog.LevelDebug - 4, expectedLogLevel: "trace"},
+		"debug-1 maps to trace":        {level: slog.LevelDebug - 1, expectedLogLevel: "trace"},
+		"warn+1 maps to warning level": {level: slog.LevelWarn + 1, expectedLogLevel: "warning"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithLevel(LevelTrace))
+			logger.Slog().Log(context.Background(), tc.level, "foobar")
+			assertLogEntryContains(t, buf, "level", tc.expectedLogLevel)
+		})
+	}
+}
+
+func TestSlogEnabled(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelWarn))
+	sl := logger.Slog()
+
+	assert.False(t, sl.Enabled(context.Background(), slog.LevelInfo))
+	assert.True(t, sl.Enabled(context.Background(), slog.LevelWarn))
+
+	sl.Info("filtered")
+	assert.False(t, wasLogged(t, buf))
+}
+
+func TestSlogAttrsAndGroups(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo), WithNowFunc(mockNowFunc), WithReportCaller(false))
+
+	sl := logger.Slog().With("service", "billing").WithGroup("req").With("id", 42)
+	sl.Info("hello", "path", "/invoices", slog.Group("user", "name", "peter"), slog.Group("empty"), slog.Attr{})
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, "hello", log["msg"])
+	assert.Equal(t, "billing", log["service"])
+	assert.Equal(t, map[string]any{
+		"id":   float64(42),
+		"path": "/invoices",
+		"user": map[string]any{"name": "peter"},
+	}, log["req"])
+	assert.NotContains(t, log, "empty")
+}
+
+func TestSlogGroupWithoutAttrsIsOmitted(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo), WithReportCaller(false))
+
+	logger.Slog().WithGroup("req").Info("hello")
+
+	log := decodeLogToMap(t, buf)
+	assert.NotContains(t, log, "req")
+}
+
+func TestSlogHandlerDoesNotMutateParent(t *testing.T) {
+	builder := &strings.Builder{}
+	logger := New(WithOutput(builder), WithLevel(LevelInfo), WithReportCaller(false))
+
+	parent := logger.Slog().WithGroup("req").With("id", 1)
+	parent.With("child", true).Info("child")
+	parent.Info("parent")
+
+	lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, map[string]any{"id": float64(1), "child": true}, decodeLogToMap(t, strings.NewReader(lines[0]))["req"])
+	assert.Equal(t, map[string]any{"id": float64(1)}, decodeLogToMap(t, strings.NewReader(lines[1]))["req"])
+}
+
+func TestSlogReportsCaller(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo))
+
+	logger.Slog().Info("hello")
+
+	log := decodeLogToMap(t, buf)
+	assert.Regexp(t, regexp.MustCompile(`slog_test\.go:\d+$`), log["file"])
+	assert.Equal(t, "github.com/coopnorge/go-logger.TestSlogReportsCaller", log["function"])
+}
+
+func TestSlogFiresHooks(t *testing.T) {
+	ctx := context.WithValue(context.Background(), myCtxKey{}, "my-custom-ctx-value")
+
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithHookFunc(testHook))
+	logger.Slog().ErrorContext(ctx, "foobar", "error", errors.New("some error"))
+
+	log := decodeLogToMap(t, buf)
+	assert.Equal(t, "my-custom-ctx-value", log["my-custom-log-key"])
+	assert.Equal(t, "some error", log["error"])
+}
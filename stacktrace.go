@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// StacktraceFormat is the way stack traces added with WithStacktraceLevel are
+// written.
+type StacktraceFormat uint8
+
+const (
+	// StacktraceString writes the stack trace as a single string, with the
+	// function and file:line of every frame, like the stack traces of a panic.
+	StacktraceString StacktraceFormat = iota
+	// StacktraceFrames writes the stack trace as an array of frames, each
+	// with the fields function, file and line.
+	StacktraceFrames
+)
+
+const (
+	stacktraceKey = "stacktrace"
+	// maximumStacktraceDepth limits the number of frames in a stack trace.
+	maximumStacktraceDepth = 64
+)
+
+// stacktraceField returns the stack trace of the current goroutine as a field,
+// starting at caller when it is on the stack, or else at the first calling
+// function outside go-logger and the helper packages.
+func (logger *Logger) stacktraceField(caller *runtime.Frame) Field {
+	var frames []runtime.Frame
+	walkCallers(maximumStacktraceDepth, logger.callerSkip, func(f runtime.Frame) bool {
+		// Entries logged through slog have their caller below the slog frames.
+		if caller != nil && len(frames) > 0 && f.PC == caller.PC {
+			frames = frames[:0]
+		}
+		frames = append(frames, f)
+		return true
+	})
+
+	if logger.stacktraceFormat == StacktraceFrames {
+		fields := make([]Fields, len(frames))
+		for i, f := range frames {
+			fields[i] = Fields{"function": f.Function, "file": f.File, "line": f.Line}
+		}
+		return Any(stacktraceKey, fields)
+	}
+	return String(stacktraceKey, formatFrames(frames))
+}
+
+// formatFrames formats frames like the stack traces of a panic, with the
+// function and file:line of every frame.
+func formatFrames(frames []runtime.Frame) string {
+	var b strings.Builder
+	for _, f := range frames {
+		if f.Function != "" || f.File != "" {
+			fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+		}
+	}
+	return b.String()
+}
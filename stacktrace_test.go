@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStacktraceLevel(t *testing.T) {
+	testCases := map[string]struct {
+		level    Level
+		logLevel Level
+		expected bool
+	}{
+		"error at error":   {level: LevelError, logLevel: LevelError, expected: true},
+		"fatal at error":   {level: LevelError, logLevel: LevelFatal, expected: true},
+		"panic at error":   {level: LevelError, logLevel: LevelPanic, expected: true},
+		"warn at error":    {level: LevelError, logLevel: LevelWarn, expected: false},
+		"info at debug":    {level: LevelDebug, logLevel: LevelInfo, expected: true},
+		"error at off":     {level: LevelOff, logLevel: LevelError, expected: false},
+		"warn at warn":     {level: LevelWarn, logLevel: LevelWarn, expected: true},
+		"trace at default": {level: LevelOff, logLevel: LevelTrace, expected: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithLevel(LevelTrace), WithStacktraceLevel(tc.level), WithExitFunc(func(int) {}))
+			func() {
+				defer func() { _ = recover() }()
+				logger.Log(tc.logLevel, "foobar")
+			}()
+			_, ok := decodeLogToMap(t, buf)[stacktraceKey]
+			assert.Equal(t, tc.expected, ok)
+		})
+	}
+}
+
+func TestStacktraceStartsAtCaller(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	testCases := map[string]func(logger *Logger){
+		"Error":      func(logger *Logger) { logger.Error("foobar") },
+		"WithField":  func(logger *Logger) { logger.WithField("foo", "bar").Errorf("foobar") },
+		"LogFields":  func(logger *Logger) { logger.LogFields(LevelError, "foobar", String("foo", "bar")) },
+		"Errorw":     func(logger *Logger) { logger.Errorw("foobar", "foo", "bar") },
+		"slog":       func(logger *Logger) { slog.New(NewSlogHandler(logger)).Error("foobar") },
+		"global Err": func(*Logger) { Error("foobar") },
+	}
+	for name, log := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := New(WithOutput(buf), WithStacktraceLevel(LevelError))
+			if name == "global Err" {
+				old := globalLogger
+				globalLogger = logger
+				defer func() { globalLogger = old }()
+			}
+			log(logger)
+
+			stack, ok := decodeLogToMap(t, buf)[stacktraceKey].(string)
+			require.True(t, ok)
+			assert.Regexp(t, `^github\.com/coopnorge/go-logger\.TestStacktraceStartsAtCaller\.func\d+\n\t.*stacktrace_test\.go:\d+\n`, stack)
+			assert.Contains(t, stack, "testing.tRunner")
+		})
+	}
+}
+
+func TestStacktraceFrames(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithStacktraceLevel(LevelError), WithStacktraceFormat(StacktraceFrames))
+
+	logger.Error("foobar")
+
+	frames, ok := decodeLogToMap(t, buf)[stacktraceKey].([]any)
+	require.True(t, ok)
+	require.NotEmpty(t, frames)
+	frame, ok := frames[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "github.com/coopnorge/go-logger.TestStacktraceFrames", frame["function"])
+	assert.Regexp(t, `stacktrace_test\.go$`, frame["file"])
+	assert.Greater(t, frame["line"], float64(0))
+}
+
+func TestStacktraceHooks(t *testing.T) {
+	reportCallerInGoLoggerPackage = true
+	buf := &bytes.Buffer{}
+	var stack any
+	logger := New(WithOutput(buf), WithStacktraceLevel(LevelError), WithHookFunc(func(e *HookEntry) (bool, error) {
+		stack = e.Data[stacktraceKey]
+		return false, nil
+	}))
+
+	logger.Error("foobar")
+	assert.Contains(t, stack, "TestStacktraceHooks")
+}
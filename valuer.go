@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"log/slog"
+)
+
+// Lazy returns a value for a field that is computed by calling f when an
+// entry with the field is written. f is not called for entries that are
+// filtered out by their level, so expensive values can be logged at debug
+// level without slowing down the service when debug logging is off.
+//
+//	logger.WithField("order", logger.Lazy(func() any { return dump(order) })).Debug("order received")
+func Lazy(f func() any) slog.LogValuer {
+	return lazyValue(f)
+}
+
+type lazyValue func() any
+
+func (f lazyValue) LogValue() slog.Value {
+	return slog.AnyValue(f())
+}
+
+// resolveValue returns the value of v when it implements slog.LogValuer, so
+// types can control how they are logged, e.g. to redact secrets. Values are
+// resolved when an entry is written, not when the field is added. A group
+// value is returned as nested Fields.
+func resolveValue(v any) any {
+	lv, ok := v.(slog.LogValuer)
+	if !ok {
+		return v
+	}
+	value := slog.AnyValue(lv).Resolve()
+	if value.Kind() != slog.KindGroup {
+		return value.Any()
+	}
+	fields := Fields{}
+	for _, a := range value.Group() {
+		addSlogAttr(fields, a)
+	}
+	return fields
+}
+
+// resolved returns f with its value resolved when it implements
+// slog.LogValuer, for encoders that inspect the value more than once.
+func resolved(f Field) Field {
+	if f.kind == anyKind {
+		f.value = resolveValue(f.value)
+	}
+	return f
+}
+
+// resolveField returns the value of f as it would be stored in Fields, with
+// values implementing slog.LogValuer resolved.
+func resolveField(f Field) any {
+	switch f.kind {
+	case anyKind:
+		return resolveValue(f.value)
+	case objectKind:
+		fields := Fields{}
+		for _, member := range f.value.([]Field) {
+			if member.kind != skipKind {
+				fields[member.key] = resolveField(member)
+			}
+		}
+		return fields
+	}
+	return f.Value()
+}
@@ -0,0 +1,136 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type secret string
+
+func (secret) LogValue() slog.Value {
+	return slog.StringValue("REDACTED")
+}
+
+type user struct {
+	name     string
+	password secret
+}
+
+func (u user) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("name", u.name), slog.Any("password", u.password))
+}
+
+func TestLogValuer(t *testing.T) {
+	testCases := map[string]struct {
+		log      func(logger *Logger)
+		key      string
+		expected any
+	}{
+		"WithField": {
+			log:      func(logger *Logger) { logger.WithField("password", secret("hunter2")).Info("foobar") },
+			key:      "password",
+			expected: "REDACTED",
+		},
+		"Any": {
+			log:      func(logger *Logger) { logger.LogFields(LevelInfo, "foobar", Any("password", secret("hunter2"))) },
+			key:      "password",
+			expected: "REDACTED",
+		},
+		"Object": {
+			log: func(logger *Logger) {
+				logger.LogFields(LevelInfo, "foobar", Object("user", Any("password", secret("hunter2"))))
+			},
+			key:      "user",
+			expected: map[string]any{"password": "REDACTED"},
+		},
+		"nested in Fields": {
+			log: func(logger *Logger) {
+				logger.WithField("user", Fields{"password": secret("hunter2"), "name": "peter"}).Info("foobar")
+			},
+			key:      "user",
+			expected: map[string]any{"name": "peter", "password": "REDACTED"},
+		},
+		"Lazy nested in Fields": {
+			log: func(logger *Logger) {
+				logger.WithField("user", Fields{"id": Lazy(func() any { return 42 })}).Info("foobar")
+			},
+			key:      "user",
+			expected: map[string]any{"id": float64(42)},
+		},
+		"Group": {
+			log:      func(logger *Logger) { logger.Infow("foobar", "user", user{name: "peter", password: "hunter2"}) },
+			key:      "user",
+			expected: map[string]any{"name": "peter", "password": "REDACTED"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Run("JSON", func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				tc.log(New(WithOutput(buf), WithLevel(LevelInfo)))
+				assert.Equal(t, tc.expected, decodeLogToMap(t, buf)[tc.key])
+			})
+			t.Run("hooks", func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				var data Fields
+				tc.log(New(WithOutput(buf), WithLevel(LevelInfo), WithHookFunc(func(e *HookEntry) (bool, error) {
+					data = e.Data
+					return false, nil
+				})))
+				assert.NotContains(t, data[tc.key], "hunter2")
+				assert.Equal(t, tc.expected, decodeLogToMap(t, buf)[tc.key])
+			})
+			t.Run("slog", func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				tc.log(New(WithSlogHandler(slog.NewJSONHandler(buf, nil)), WithLevel(LevelInfo)))
+				assert.Equal(t, tc.expected, decodeLogToMap(t, buf)[tc.key])
+			})
+		})
+	}
+}
+
+func TestLazy(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := New(WithOutput(buf), WithLevel(LevelInfo))
+
+	calls := 0
+	value := Lazy(func() any {
+		calls++
+		return "expensive"
+	})
+
+	logger.WithField("value", value).Debug("filtered")
+	logger.LogFields(LevelDebug, "filtered", Any("value", value))
+	assert.Equal(t, 0, calls)
+	assert.False(t, wasLogged(t, buf))
+
+	child := logger.With(Any("value", value))
+	assert.Equal(t, 0, calls)
+	child.Info("foobar")
+	assert.Equal(t, 1, calls)
+	assertLogEntryContains(t, buf, "value", "expensive")
+
+	child.Info("foobar")
+	assert.Equal(t, 2, calls)
+}
+
+func TestLazyIsResolvedOnce(t *testing.T) {
+	formats := map[string]Format{"JSON": FormatJSON, "console": FormatConsole, "logfmt": FormatLogfmt}
+	for name, format := range formats {
+		t.Run(name, func(t *testing.T) {
+			logger := New(WithOutput(io.Discard), WithFormat(format))
+
+			calls := 0
+			value := Lazy(func() any {
+				calls++
+				return "expensive"
+			})
+			logger.WithField("user", value).LogFields(LevelWarn, "foobar", Any("order", value))
+			assert.Equal(t, 2, calls)
+		})
+	}
+}